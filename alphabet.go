@@ -0,0 +1,164 @@
+package validator
+
+import (
+	"strings"
+	"unicode"
+)
+
+// alphabet is a set of scripts whose letters are accepted, together with
+// Common script letters such as the Japanese prolonged sound mark that are
+// part of the writing system.
+type alphabet struct {
+	scripts []*unicode.RangeTable
+	letters string
+}
+
+var (
+	latinAlphabet      = alphabet{scripts: []*unicode.RangeTable{unicode.Latin}}
+	cyrillicAlphabet   = alphabet{scripts: []*unicode.RangeTable{unicode.Cyrillic}}
+	greekAlphabet      = alphabet{scripts: []*unicode.RangeTable{unicode.Greek}}
+	arabicAlphabet     = alphabet{scripts: []*unicode.RangeTable{unicode.Arabic}, letters: "ـ"}
+	hebrewAlphabet     = alphabet{scripts: []*unicode.RangeTable{unicode.Hebrew}}
+	hanAlphabet        = alphabet{scripts: []*unicode.RangeTable{unicode.Han}}
+	japaneseAlphabet   = alphabet{scripts: []*unicode.RangeTable{unicode.Hiragana, unicode.Katakana, unicode.Han}, letters: "ーｰ"}
+	hangulAlphabet     = alphabet{scripts: []*unicode.RangeTable{unicode.Hangul}}
+	devanagariAlphabet = alphabet{scripts: []*unicode.RangeTable{unicode.Devanagari}}
+
+	// scriptAlphabets maps ISO 15924 codes to alphabets.
+	scriptAlphabets = map[string]alphabet{
+		"Latn": latinAlphabet, "Cyrl": cyrillicAlphabet, "Grek": greekAlphabet,
+		"Arab": arabicAlphabet, "Hebr": hebrewAlphabet, "Hani": hanAlphabet,
+		"Hans": hanAlphabet, "Hant": hanAlphabet, "Jpan": japaneseAlphabet,
+		"Hang": hangulAlphabet, "Kore": {scripts: []*unicode.RangeTable{unicode.Hangul, unicode.Han}},
+		"Deva": devanagariAlphabet,
+		"Hira": {scripts: []*unicode.RangeTable{unicode.Hiragana}, letters: "ー"},
+		"Kana": {scripts: []*unicode.RangeTable{unicode.Katakana}, letters: "ーｰ"},
+		"Thai": {scripts: []*unicode.RangeTable{unicode.Thai}},
+		"Geor": {scripts: []*unicode.RangeTable{unicode.Georgian}},
+		"Armn": {scripts: []*unicode.RangeTable{unicode.Armenian}},
+		"Beng": {scripts: []*unicode.RangeTable{unicode.Bengali}},
+		"Taml": {scripts: []*unicode.RangeTable{unicode.Tamil}},
+	}
+
+	// languageScripts maps ISO 639-1 language codes to the ISO 15924 code of
+	// the script they are usually written in.
+	languageScripts = map[string]string{
+		"en": "Latn", "de": "Latn", "fr": "Latn", "es": "Latn", "it": "Latn",
+		"pt": "Latn", "nl": "Latn", "pl": "Latn", "cs": "Latn", "sk": "Latn",
+		"tr": "Latn", "vi": "Latn", "sv": "Latn", "da": "Latn", "no": "Latn",
+		"nb": "Latn", "nn": "Latn", "fi": "Latn", "hu": "Latn", "ro": "Latn",
+		"hr": "Latn", "sl": "Latn", "id": "Latn", "ms": "Latn", "et": "Latn",
+		"lv": "Latn", "lt": "Latn", "is": "Latn", "ga": "Latn", "ca": "Latn",
+		"ru": "Cyrl", "uk": "Cyrl", "bg": "Cyrl", "sr": "Cyrl", "mk": "Cyrl",
+		"be": "Cyrl", "kk": "Cyrl",
+		"el": "Grek",
+		"ar": "Arab", "fa": "Arab", "ur": "Arab",
+		"he": "Hebr", "yi": "Hebr",
+		"zh": "Hani", "ja": "Jpan", "ko": "Hang",
+		"hi": "Deva", "mr": "Deva", "ne": "Deva", "sa": "Deva",
+		"th": "Thai", "ka": "Geor", "hy": "Armn", "bn": "Beng", "ta": "Taml",
+	}
+)
+
+// IsAlphaScript reports whether str is non-empty and consists of letters of
+// the given scripts, each optionally followed by combining marks such as a
+// decomposed accent or a Devanagari vowel sign:
+//
+//	validator.IsAlphaScript("Ελλάδα", unicode.Greek) // true
+func IsAlphaScript(str string, scripts ...*unicode.RangeTable) bool {
+	return alphabet{scripts: scripts}.matches(str)
+}
+
+// IsAlphaLanguage reports whether str consists of letters of the alphabet of
+// a BCP 47 language tag. An explicit script subtag takes precedence, so sr
+// accepts Cyrillic while sr-Latn accepts Latin. Unknown languages are
+// rejected.
+func IsAlphaLanguage(str string, language string) bool {
+	tag, err := ParseBCP47(language)
+	if err != nil {
+		return false
+	}
+
+	script := tag.Script
+	if script == "" {
+		script = languageScripts[tag.Language]
+	}
+	alphabet, ok := scriptAlphabets[script]
+
+	return ok && alphabet.matches(str)
+}
+
+// IsAlphaLatin accepts Latin letters including those with diacritics, such as
+// Ærøskøbing or Đorđević.
+func IsAlphaLatin(str string) bool {
+	return latinAlphabet.matches(str)
+}
+
+func IsAlphaCyrillic(str string) bool {
+	return cyrillicAlphabet.matches(str)
+}
+
+func IsAlphaGreek(str string) bool {
+	return greekAlphabet.matches(str)
+}
+
+// IsAlphaArabic accepts Arabic letters, harakat and the tatweel.
+func IsAlphaArabic(str string) bool {
+	return arabicAlphabet.matches(str)
+}
+
+func IsAlphaHebrew(str string) bool {
+	return hebrewAlphabet.matches(str)
+}
+
+func IsAlphaHan(str string) bool {
+	return hanAlphabet.matches(str)
+}
+
+// IsAlphaJapanese accepts Hiragana, Katakana and Kanji together with the
+// prolonged sound mark ー.
+func IsAlphaJapanese(str string) bool {
+	return japaneseAlphabet.matches(str)
+}
+
+func IsAlphaHangul(str string) bool {
+	return hangulAlphabet.matches(str)
+}
+
+// IsAlphaDevanagari accepts Devanagari letters with their vowel signs and
+// other combining marks, such as हिन्दी.
+func IsAlphaDevanagari(str string) bool {
+	return devanagariAlphabet.matches(str)
+}
+
+// matches reports whether str is a non-empty sequence of letters of the
+// alphabet, each followed by any number of combining marks that are either
+// Inherited or belong to one of the alphabet's scripts.
+func (a alphabet) matches(str string) bool {
+	if str == "" {
+		return false
+	}
+
+	afterLetter := false
+	for _, r := range str {
+		switch {
+		case unicode.IsLetter(r) && (a.inScripts(r) || strings.ContainsRune(a.letters, r)):
+			afterLetter = true
+		case unicode.IsMark(r) && afterLetter && (unicode.Is(unicode.Inherited, r) || a.inScripts(r)):
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+func (a alphabet) inScripts(r rune) bool {
+	for _, script := range a.scripts {
+		if unicode.Is(script, r) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,108 @@
+package validator_test
+
+import (
+	validator "github.com/MrWormHole/simple-validator"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"unicode"
+)
+
+func TestIsAlphaScript(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.True(validator.IsAlphaScript("Ελλάδα", unicode.Greek))
+	assert.True(validator.IsAlphaScript("alphaΩ", unicode.Latin, unicode.Greek))
+	assert.False(validator.IsAlphaScript("alphaΩ", unicode.Latin))
+	assert.False(validator.IsAlphaScript("abc"))
+	assert.False(validator.IsAlphaScript("", unicode.Latin))
+}
+
+func TestIsAlphaLatin(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []testCase{
+		{"abc", true},
+		{"Ærøskøbing", true},
+		{"Đorđević", true},
+		{"straße", true},
+		{"cafe\u0301", true},
+		{"\u0301cafe", false},
+		{"pаypal", false},
+		{"abc1", false},
+		{"two words", false},
+		{"", false},
+	}
+
+	for _, t := range testCases {
+		actual := validator.IsAlphaLatin(t.param)
+		assert.Equal(t.expected, actual, t.param)
+	}
+}
+
+func TestIsAlphaScripts(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []struct {
+		param    string
+		fn       func(string) bool
+		expected bool
+	}{
+		{"Привет", validator.IsAlphaCyrillic, true},
+		{"Ёлка", validator.IsAlphaCyrillic, true},
+		{"Привет!", validator.IsAlphaCyrillic, false},
+		{"Ελληνικά", validator.IsAlphaGreek, true},
+		{"Greek", validator.IsAlphaGreek, false},
+		{"العربية", validator.IsAlphaArabic, true},
+		{"مَرْحَبًا", validator.IsAlphaArabic, true},
+		{"كـتـاب", validator.IsAlphaArabic, true},
+		{"عربي١", validator.IsAlphaArabic, false},
+		{"עברית", validator.IsAlphaHebrew, true},
+		{"שָׁלוֹם", validator.IsAlphaHebrew, true},
+		{"汉字", validator.IsAlphaHan, true},
+		{"ひらがな", validator.IsAlphaHan, false},
+		{"ひらがなカタカナ漢字", validator.IsAlphaJapanese, true},
+		{"コーヒー", validator.IsAlphaJapanese, true},
+		{"ｺｰﾋｰ", validator.IsAlphaJapanese, true},
+		{"日本、語", validator.IsAlphaJapanese, false},
+		{"한국어", validator.IsAlphaHangul, true},
+		{"韓國", validator.IsAlphaHangul, false},
+		{"हिन्दी", validator.IsAlphaDevanagari, true},
+		{"नमस्ते", validator.IsAlphaDevanagari, true},
+		{"िहि", validator.IsAlphaDevanagari, false},
+		{"हिन्दी१", validator.IsAlphaDevanagari, false},
+	}
+
+	for _, t := range testCases {
+		actual := t.fn(t.param)
+		assert.Equal(t.expected, actual, t.param)
+	}
+}
+
+func TestIsAlphaLanguage(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []struct {
+		param    string
+		language string
+		expected bool
+	}{
+		{"Größe", "de", true},
+		{"Größe", "de-CH", true},
+		{"Москва", "ru", true},
+		{"Москва", "en", false},
+		{"Београд", "sr", true},
+		{"Beograd", "sr-Latn", true},
+		{"Beograd", "sr", false},
+		{"東京タワー", "ja", true},
+		{"서울", "ko", true},
+		{"서울漢字", "ko-Kore", true},
+		{"नमस्ते", "hi-IN", true},
+		{"abc", "tlh", false},
+		{"abc", "not a tag", false},
+	}
+
+	for _, t := range testCases {
+		actual := validator.IsAlphaLanguage(t.param, t.language)
+		assert.Equal(t.expected, actual, t.param+" "+t.language)
+	}
+}
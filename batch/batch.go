@@ -0,0 +1,214 @@
+// Package batch validates datasets such as customer import files against a
+// Schema mapping their columns or fields to validate rules:
+//
+//	schema, err := batch.LoadSchema("customers.yaml")
+//	if err != nil {
+//		return err
+//	}
+//	report, err := batch.ValidateCSV(ctx, file, schema, batch.Options{MaxExamples: 3})
+//
+// CSV and JSONL input is read as a stream and its rows are validated
+// concurrently. The Report lists every failure by row and field, and
+// summarizes the failures of each field and rule with their count and first
+// examples.
+//
+// Values are validated as strings, as they are read from the file, with
+// validator.ValidateValue: min, max and len count characters, and a missing
+// column or field is the empty string, so that required and omitempty apply
+// to it.
+package batch
+
+import (
+	"context"
+	"runtime"
+	"sort"
+	"sync"
+
+	validator "github.com/MrWormHole/simple-validator"
+)
+
+// DefaultMaxExamples is the number of examples kept per field and rule when
+// Options.MaxExamples is zero.
+const DefaultMaxExamples = 5
+
+// SyntaxRule is the rule of the failures reported for rows that cannot be
+// read, such as malformed JSON or a CSV record with too many fields. Such rows
+// are not validated further.
+const SyntaxRule = "syntax"
+
+// Options configures ValidateCSV and ValidateJSONL.
+type Options struct {
+	// Workers is the number of goroutines validating rows, or
+	// runtime.GOMAXPROCS when zero.
+	Workers int
+	// MaxExamples is the number of failures kept as examples in each
+	// Summary, or DefaultMaxExamples when zero. A negative value keeps none.
+	MaxExamples int
+}
+
+// Failure describes a value that failed a rule of its field.
+type Failure struct {
+	// Row is the position of the row among the data rows, from 1; the CSV
+	// header is not a data row.
+	Row int `json:"row"`
+	// Line is the line of the input on which the row starts.
+	Line    int    `json:"line"`
+	Field   string `json:"field,omitempty"`
+	Rule    string `json:"rule"`
+	Value   string `json:"value,omitempty"`
+	Message string `json:"message"`
+}
+
+// Summary counts the failures of a field and rule.
+type Summary struct {
+	Field    string    `json:"field,omitempty"`
+	Rule     string    `json:"rule"`
+	Count    int       `json:"count"`
+	Examples []Failure `json:"examples,omitempty"`
+}
+
+// Report is the result of validating a dataset.
+type Report struct {
+	Rows        int `json:"rows"`
+	InvalidRows int `json:"invalid_rows"`
+	// Failures lists every failure, by row and then in schema order.
+	Failures []Failure `json:"failures"`
+	// Summaries lists the fields and rules that failed in schema order, and
+	// syntax errors first.
+	Summaries []Summary `json:"summaries"`
+}
+
+// Valid reports whether every row of the dataset is valid.
+func (r *Report) Valid() bool {
+	return r.InvalidRows == 0
+}
+
+// row is a record read from the input. values holds the value of each field
+// of the schema, unless err describes why the row cannot be read. Rows that
+// are decoded by the workers hold their text in raw until then.
+type row struct {
+	index  int
+	line   int
+	raw    string
+	values []string
+	err    string
+}
+
+// validateRows validates the rows sent by read with a pool of workers and
+// builds the report. read stops early, returning ctx.Err(), when ctx is done.
+// When decode is not nil, the workers call it on each row before validating
+// it.
+func validateRows(ctx context.Context, schema Schema, opts Options, read func(ctx context.Context, rows chan<- row) error, decode func(r *row)) (*Report, error) {
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	rows := make(chan row, workers*4)
+	results := make(chan []Failure, workers*4)
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for r := range rows {
+				if decode != nil {
+					decode(&r)
+				}
+				results <- validateRow(schema, r)
+			}
+		}()
+	}
+
+	var readErr error
+	go func() {
+		readErr = read(ctx, rows)
+		close(rows)
+		wg.Wait()
+		close(results)
+	}()
+
+	report := &Report{Failures: []Failure{}, Summaries: []Summary{}}
+	for failures := range results {
+		report.Rows++
+		if len(failures) > 0 {
+			report.InvalidRows++
+			report.Failures = append(report.Failures, failures...)
+		}
+	}
+	if readErr != nil {
+		return nil, readErr
+	}
+
+	summarize(report, schema, opts)
+
+	return report, nil
+}
+
+// validateRow applies the rules of every field to the values of r.
+func validateRow(schema Schema, r row) []Failure {
+	if r.err != "" {
+		return []Failure{{Row: r.index, Line: r.line, Rule: SyntaxRule, Message: r.err}}
+	}
+
+	var failures []Failure
+	for i, field := range schema {
+		err := validator.ValidateValue(r.values[i], field.Rules)
+		if err == nil {
+			continue
+		}
+
+		failure := Failure{Row: r.index, Line: r.line, Field: field.Name, Value: r.values[i], Message: err.Error()}
+		if fieldErr, ok := err.(*validator.FieldError); ok {
+			failure.Rule = fieldErr.Rule
+		}
+		failures = append(failures, failure)
+	}
+
+	return failures
+}
+
+// summarize sorts the failures of report and counts them by field and rule.
+func summarize(report *Report, schema Schema, opts Options) {
+	fieldOrder := make(map[string]int, len(schema))
+	for i, field := range schema {
+		fieldOrder[field.Name] = i + 1
+	}
+
+	// Workers finish rows out of order; the failures of a row are already
+	// in schema order.
+	sort.SliceStable(report.Failures, func(i, j int) bool {
+		return report.Failures[i].Row < report.Failures[j].Row
+	})
+
+	maxExamples := opts.MaxExamples
+	if maxExamples == 0 {
+		maxExamples = DefaultMaxExamples
+	}
+
+	type key struct{ field, rule string }
+	summaries := map[key]int{}
+	for _, failure := range report.Failures {
+		k := key{failure.Field, failure.Rule}
+		i, ok := summaries[k]
+		if !ok {
+			i = len(report.Summaries)
+			summaries[k] = i
+			report.Summaries = append(report.Summaries, Summary{Field: failure.Field, Rule: failure.Rule})
+		}
+
+		summary := &report.Summaries[i]
+		summary.Count++
+		if len(summary.Examples) < maxExamples {
+			summary.Examples = append(summary.Examples, failure)
+		}
+	}
+
+	sort.SliceStable(report.Summaries, func(i, j int) bool {
+		return fieldOrder[report.Summaries[i].Field] < fieldOrder[report.Summaries[j].Field]
+	})
+}
@@ -0,0 +1,168 @@
+package batch_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/MrWormHole/simple-validator/batch"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"strings"
+	"testing"
+)
+
+const customersCSV = "\ufeffid,email,iban,latitude,country,plan\n" +
+	"1,john.doe@example.com,DE89370400440532013000,52.52,DE,pro\n" +
+	"2,jane@,DE89370400440532013000,,DE,free\n" +
+	"3,max@example.com,DE88370400440532013000,91,Germany,\n" +
+	"4,\"anna@example.com\",GB82WEST12345698765432\n" +
+	"5,ola@example.no,NO9386011117947,59.91,NO,enterprise\n"
+
+func TestValidateCSV(t *testing.T) {
+	assert := assert.New(t)
+
+	report, err := batch.ValidateCSV(context.Background(), strings.NewReader(customersCSV), customerSchema, batch.Options{Workers: 3})
+	assert.NoError(err)
+	assert.False(report.Valid())
+	assert.Equal(5, report.Rows)
+	assert.Equal(4, report.InvalidRows)
+	assert.Equal([]batch.Failure{
+		{Row: 2, Line: 3, Field: "email", Rule: "email", Value: "jane@", Message: "must be a valid email"},
+		{Row: 3, Line: 4, Field: "iban", Rule: "iban", Value: "DE88370400440532013000", Message: "must be a valid iban"},
+		{Row: 3, Line: 4, Field: "latitude", Rule: "latitude", Value: "91", Message: "must be a valid latitude"},
+		{Row: 3, Line: 4, Field: "country", Rule: "iso3166_alpha2", Value: "Germany", Message: "must be a valid iso3166_alpha2"},
+		{Row: 3, Line: 4, Field: "plan", Rule: "oneof", Message: "must be one of free pro"},
+		{Row: 4, Line: 5, Rule: batch.SyntaxRule, Message: "wrong number of fields"},
+		{Row: 5, Line: 6, Field: "plan", Rule: "oneof", Value: "enterprise", Message: "must be one of free pro"},
+	}, report.Failures)
+
+	rules := make([]string, len(report.Summaries))
+	for i, summary := range report.Summaries {
+		rules[i] = fmt.Sprintf("%s/%s=%d", summary.Field, summary.Rule, summary.Count)
+	}
+	assert.Equal([]string{"/syntax=1", "email/email=1", "iban/iban=1", "latitude/latitude=1", "country/iso3166_alpha2=1", "plan/oneof=2"}, rules)
+
+	report, err = batch.ValidateCSV(context.Background(), strings.NewReader("email,iban,latitude,country,plan\n"), customerSchema, batch.Options{})
+	assert.NoError(err)
+	assert.True(report.Valid())
+	assert.Equal(0, report.Rows)
+	assert.Equal([]batch.Failure{}, report.Failures)
+}
+
+func TestValidateCSVErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := batch.ValidateCSV(context.Background(), strings.NewReader(""), customerSchema, batch.Options{})
+	assert.EqualError(err, "batch: missing CSV header")
+
+	var schemaErr *batch.SchemaError
+	_, err = batch.ValidateCSV(context.Background(), strings.NewReader("email,iban\n"), customerSchema, batch.Options{})
+	assert.True(errors.As(err, &schemaErr))
+	assert.EqualError(err, "batch: invalid schema for latitude: no such column in the CSV header")
+
+	_, err = batch.ValidateCSV(context.Background(), strings.NewReader("email\n"), batch.Schema{{Name: "email", Rules: "emial"}}, batch.Options{})
+	assert.True(errors.As(err, &schemaErr))
+
+	_, err = batch.ValidateCSV(context.Background(), strings.NewReader("email\n"), batch.Schema{}, batch.Options{})
+	assert.True(errors.As(err, &schemaErr))
+
+	_, err = batch.ValidateCSV(context.Background(), io.MultiReader(strings.NewReader("email\na@example.com\n"), errReader{}), batch.Schema{{Name: "email", Rules: "email"}}, batch.Options{})
+	assert.ErrorIs(err, errRead)
+}
+
+func TestValidateJSONL(t *testing.T) {
+	assert := assert.New(t)
+
+	input := `{"email": "john.doe@example.com", "iban": "DE89 3704 0044 0532 0130 00", "address": {"latitude": 52.52, "country": "DE"}}
+{"email": "jane@", "iban": null, "address": {"latitude": -91, "country": "DE"}, "plan": "free"}
+
+{"email": "max@example.com", "iban": "GB82WEST12345698765432", "address.country": "GB", "plan": true}
+{"email": "anna@example.com",
+[1, 2]
+`
+	schema := batch.Schema{
+		{Name: "email", Rules: "required,email"},
+		{Name: "iban", Rules: "required,iban"},
+		{Name: "address.latitude", Rules: "omitempty,latitude"},
+		{Name: "address.country", Rules: "required,iso3166_alpha2"},
+		{Name: "plan", Rules: "omitempty,oneof=free pro"},
+	}
+
+	report, err := batch.ValidateJSONL(context.Background(), strings.NewReader(input), schema, batch.Options{MaxExamples: 1})
+	assert.NoError(err)
+	assert.Equal(5, report.Rows)
+	assert.Equal(4, report.InvalidRows)
+	assert.Equal([]batch.Failure{
+		{Row: 2, Line: 2, Field: "email", Rule: "email", Value: "jane@", Message: "must be a valid email"},
+		{Row: 2, Line: 2, Field: "iban", Rule: "required", Message: "is required"},
+		{Row: 2, Line: 2, Field: "address.latitude", Rule: "latitude", Value: "-91", Message: "must be a valid latitude"},
+		{Row: 3, Line: 4, Field: "plan", Rule: "oneof", Value: "true", Message: "must be one of free pro"},
+		{Row: 4, Line: 5, Rule: batch.SyntaxRule, Message: "unexpected end of JSON input"},
+		{Row: 5, Line: 6, Rule: batch.SyntaxRule, Message: "expected a JSON object"},
+	}, report.Failures)
+
+	assert.Equal(batch.Summary{
+		Rule:     batch.SyntaxRule,
+		Count:    2,
+		Examples: []batch.Failure{{Row: 4, Line: 5, Rule: batch.SyntaxRule, Message: "unexpected end of JSON input"}},
+	}, report.Summaries[0])
+}
+
+func TestValidateExamples(t *testing.T) {
+	assert := assert.New(t)
+
+	var input strings.Builder
+	input.WriteString("email\n")
+	for i := 0; i < 1000; i++ {
+		if i%10 == 0 {
+			fmt.Fprintf(&input, "user%d@\n", i)
+		} else {
+			fmt.Fprintf(&input, "user%d@example.com\n", i)
+		}
+	}
+	schema := batch.Schema{{Name: "email", Rules: "email"}}
+
+	report, err := batch.ValidateCSV(context.Background(), strings.NewReader(input.String()), schema, batch.Options{})
+	assert.NoError(err)
+	assert.Equal(1000, report.Rows)
+	assert.Equal(100, report.InvalidRows)
+	assert.Len(report.Summaries, 1)
+	assert.Equal(100, report.Summaries[0].Count)
+	assert.Len(report.Summaries[0].Examples, batch.DefaultMaxExamples)
+	for i, example := range report.Summaries[0].Examples {
+		assert.Equal(i*10+1, example.Row)
+	}
+
+	report, err = batch.ValidateCSV(context.Background(), strings.NewReader(input.String()), schema, batch.Options{MaxExamples: -1})
+	assert.NoError(err)
+	assert.Empty(report.Summaries[0].Examples)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = batch.ValidateCSV(ctx, strings.NewReader(input.String()), schema, batch.Options{Workers: 1})
+	assert.ErrorIs(err, context.Canceled)
+}
+
+var errRead = errors.New("read failed")
+
+type errReader struct{}
+
+func (errReader) Read(p []byte) (int, error) {
+	return 0, errRead
+}
+
+func BenchmarkValidateCSV(b *testing.B) {
+	var input strings.Builder
+	input.WriteString("email,iban,latitude,country,plan\n")
+	for i := 0; i < 10000; i++ {
+		fmt.Fprintf(&input, "user%d@example.com,DE89370400440532013000,52.52,DE,pro\n", i)
+	}
+	data := input.String()
+
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		if _, err := batch.ValidateCSV(context.Background(), strings.NewReader(data), customerSchema, batch.Options{}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
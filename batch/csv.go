@@ -0,0 +1,82 @@
+package batch
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ValidateCSV validates the records of r, a CSV file whose first record names
+// its columns, against schema. Every field of the schema must be a column of
+// the header; other columns are ignored. Records with a different number of
+// fields than the header, or that are otherwise malformed, are reported as
+// failures of SyntaxRule.
+//
+// The returned error is a *SchemaError for an invalid schema, or an error
+// reading the header or the input; failing rows are only reported in the
+// Report.
+func ValidateCSV(ctx context.Context, r io.Reader, schema Schema, opts Options) (*Report, error) {
+	if err := schema.check(); err != nil {
+		return nil, err
+	}
+
+	reader := csv.NewReader(r)
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.New("batch: missing CSV header")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("batch: reading CSV header: %w", err)
+	}
+	// Spreadsheets often export UTF-8 with a byte order mark.
+	header[0] = strings.TrimPrefix(header[0], "\ufeff")
+
+	columns := make([]int, len(schema))
+	for i, field := range schema {
+		columns[i] = -1
+		for j, name := range header {
+			if name == field.Name {
+				columns[i] = j
+				break
+			}
+		}
+		if columns[i] < 0 {
+			return nil, &SchemaError{Field: field.Name, Reason: "no such column in the CSV header"}
+		}
+	}
+
+	read := func(ctx context.Context, rows chan<- row) error {
+		for index := 1; ; index++ {
+			record, err := reader.Read()
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			var r row
+			var parseErr *csv.ParseError
+			switch {
+			case errors.As(err, &parseErr):
+				r = row{index: index, line: parseErr.StartLine, err: parseErr.Err.Error()}
+			case err != nil:
+				return fmt.Errorf("batch: reading CSV: %w", err)
+			default:
+				line, _ := reader.FieldPos(0)
+				r = row{index: index, line: line, values: make([]string, len(schema))}
+				for i, column := range columns {
+					r.values[i] = record[column]
+				}
+			}
+
+			select {
+			case rows <- r:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+
+	return validateRows(ctx, schema, opts, read, nil)
+}
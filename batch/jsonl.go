@@ -0,0 +1,111 @@
+package batch
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ValidateJSONL validates the lines of r, each a JSON object, against schema.
+// Fields of the schema are looked up by key or, for dotted names such as
+// address.city, in nested objects. Strings are validated unquoted, null and
+// missing fields as the empty string, and other values as their JSON text,
+// so that 42 is validated as "42". Blank lines are skipped; lines that are
+// not a JSON object are reported as failures of SyntaxRule.
+//
+// The returned error is a *SchemaError for an invalid schema or an error
+// reading the input; failing rows are only reported in the Report.
+func ValidateJSONL(ctx context.Context, r io.Reader, schema Schema, opts Options) (*Report, error) {
+	if err := schema.check(); err != nil {
+		return nil, err
+	}
+
+	read := func(ctx context.Context, rows chan<- row) error {
+		reader := bufio.NewReader(r)
+		index := 0
+		for line := 1; ; line++ {
+			text, err := reader.ReadString('\n')
+			if err != nil && !errors.Is(err, io.EOF) {
+				return fmt.Errorf("batch: reading JSONL: %w", err)
+			}
+			if strings.TrimSpace(text) != "" {
+				index++
+				select {
+				case rows <- row{index: index, line: line, raw: text}:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+			if err != nil {
+				return nil
+			}
+		}
+	}
+
+	decode := func(r *row) {
+		var object map[string]json.RawMessage
+		if err := json.Unmarshal([]byte(r.raw), &object); err != nil || object == nil {
+			r.err = "expected a JSON object"
+			var syntaxErr *json.SyntaxError
+			if errors.As(err, &syntaxErr) {
+				r.err = syntaxErr.Error()
+			}
+			return
+		}
+
+		r.values = make([]string, len(schema))
+		for i, field := range schema {
+			r.values[i] = jsonValue(lookupJSON(object, field.Name))
+		}
+	}
+
+	return validateRows(ctx, schema, opts, read, decode)
+}
+
+// lookupJSON returns the value at a dotted path in object, preferring keys
+// that contain the dots themselves, or nil.
+func lookupJSON(object map[string]json.RawMessage, path string) json.RawMessage {
+	if value, ok := object[path]; ok {
+		return value
+	}
+
+	for i := 0; i < len(path); i++ {
+		if path[i] != '.' {
+			continue
+		}
+		value, ok := object[path[:i]]
+		if !ok {
+			continue
+		}
+		var nested map[string]json.RawMessage
+		if json.Unmarshal(value, &nested) != nil {
+			continue
+		}
+		if value := lookupJSON(nested, path[i+1:]); value != nil {
+			return value
+		}
+	}
+
+	return nil
+}
+
+// jsonValue returns the string a JSON value is validated as.
+func jsonValue(value json.RawMessage) string {
+	value = bytes.TrimSpace(value)
+	switch {
+	case len(value) == 0 || string(value) == "null":
+		return ""
+	case value[0] == '"':
+		var str string
+		if json.Unmarshal(value, &str) == nil {
+			return str
+		}
+	}
+
+	return string(value)
+}
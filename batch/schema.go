@@ -0,0 +1,160 @@
+package batch
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	validator "github.com/MrWormHole/simple-validator"
+	"gopkg.in/yaml.v3"
+)
+
+// Field is a column or field of a dataset and the rules its values must
+// satisfy, written as a validate tag such as "required,email".
+type Field struct {
+	Name  string
+	Rules string
+}
+
+// Schema lists the fields of a dataset to validate, in the order their
+// failures are reported.
+type Schema []Field
+
+// SchemaError describes a schema that cannot be parsed or applied.
+type SchemaError struct {
+	Field  string
+	Line   int
+	Reason string
+}
+
+func (e *SchemaError) Error() string {
+	switch {
+	case e.Field == "" && e.Line == 0:
+		return "batch: invalid schema: " + e.Reason
+	case e.Field == "":
+		return fmt.Sprintf("batch: invalid schema at line %d: %s", e.Line, e.Reason)
+	case e.Line == 0:
+		return fmt.Sprintf("batch: invalid schema for %s: %s", e.Field, e.Reason)
+	}
+
+	return fmt.Sprintf("batch: invalid schema for %s at line %d: %s", e.Field, e.Line, e.Reason)
+}
+
+// LoadSchema reads and parses the schema file at path.
+func LoadSchema(path string) (Schema, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseSchema(data)
+}
+
+// ParseSchema parses a YAML or JSON object mapping field names to their
+// rules, given either as a validate tag or as a list of rules:
+//
+//	email: required,email
+//	iban: [required, iban]
+//	address.country: omitempty,iso3166_alpha2
+//
+// Rules are those of validator.ValidateStruct that do not refer to other
+// fields, including the validations registered with
+// validator.RegisterValidation. An unknown rule or a malformed parameter is
+// reported as a *SchemaError.
+func ParseSchema(data []byte) (Schema, error) {
+	var document yaml.Node
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return nil, &SchemaError{Reason: err.Error()}
+	}
+	if len(document.Content) == 0 {
+		return nil, &SchemaError{Reason: "empty schema"}
+	}
+
+	mapping := document.Content[0]
+	if mapping.Kind != yaml.MappingNode {
+		return nil, &SchemaError{Line: mapping.Line, Reason: "expected a mapping of field names to rules"}
+	}
+
+	schema := make(Schema, 0, len(mapping.Content)/2)
+	for i := 0; i < len(mapping.Content); i += 2 {
+		key, value := mapping.Content[i], mapping.Content[i+1]
+		if key.Kind != yaml.ScalarNode || key.Value == "" {
+			return nil, &SchemaError{Line: key.Line, Reason: "expected a field name"}
+		}
+		for _, field := range schema {
+			if field.Name == key.Value {
+				return nil, &SchemaError{Field: key.Value, Line: key.Line, Reason: "duplicate field"}
+			}
+		}
+
+		var rules []string
+		switch value.Kind {
+		case yaml.ScalarNode:
+			if value.Tag != "!!null" {
+				rules = append(rules, value.Value)
+			}
+		case yaml.SequenceNode:
+			for _, rule := range value.Content {
+				if rule.Kind != yaml.ScalarNode {
+					return nil, &SchemaError{Field: key.Value, Line: rule.Line, Reason: "expected a rule"}
+				}
+				rules = append(rules, rule.Value)
+			}
+		default:
+			return nil, &SchemaError{Field: key.Value, Line: value.Line, Reason: "expected rules as a string or a list"}
+		}
+
+		field := Field{Name: key.Value, Rules: strings.Join(rules, ",")}
+		if err := checkField(field); err != nil {
+			err.Line = value.Line
+			return nil, err
+		}
+		schema = append(schema, field)
+	}
+	if len(schema) == 0 {
+		return nil, &SchemaError{Line: mapping.Line, Reason: "empty schema"}
+	}
+
+	return schema, nil
+}
+
+// check reports the first field of the schema that cannot be applied.
+func (s Schema) check() error {
+	if len(s) == 0 {
+		return &SchemaError{Reason: "empty schema"}
+	}
+
+	for i, field := range s {
+		for _, other := range s[:i] {
+			if other.Name == field.Name {
+				return &SchemaError{Field: field.Name, Reason: "duplicate field"}
+			}
+		}
+		if err := checkField(field); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkField applies each rule of field on its own to a sample value, so that
+// every rule is reached whatever the rules before it.
+func checkField(field Field) *SchemaError {
+	if field.Name == "" {
+		return &SchemaError{Reason: "expected a field name"}
+	}
+	if field.Rules == "" {
+		return nil
+	}
+
+	for _, rule := range strings.Split(field.Rules, ",") {
+		var tagErr *validator.TagError
+		if err := validator.ValidateValue("x", rule); errors.As(err, &tagErr) {
+			return &SchemaError{Field: field.Name, Reason: fmt.Sprintf("rule %q: %s", rule, tagErr.Reason)}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package batch_test
+
+import (
+	"errors"
+	"github.com/MrWormHole/simple-validator/batch"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var customerSchema = batch.Schema{
+	{Name: "email", Rules: "required,email"},
+	{Name: "iban", Rules: "required,iban"},
+	{Name: "latitude", Rules: "omitempty,latitude"},
+	{Name: "country", Rules: "required,iso3166_alpha2"},
+	{Name: "plan", Rules: "oneof=free pro"},
+}
+
+func TestLoadSchema(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, path := range []string{"testdata/customers.yaml", "testdata/customers.json"} {
+		schema, err := batch.LoadSchema(path)
+		assert.NoError(err, path)
+		assert.Equal(customerSchema, schema, path)
+	}
+
+	_, err := batch.LoadSchema("testdata/missing.yaml")
+	assert.Error(err)
+}
+
+func TestParseSchema(t *testing.T) {
+	assert := assert.New(t)
+
+	schema, err := batch.ParseSchema([]byte("address.city: \nname: required\n"))
+	assert.NoError(err)
+	assert.Equal(batch.Schema{{Name: "address.city"}, {Name: "name", Rules: "required"}}, schema)
+
+	testCases := []struct {
+		schema string
+		err    string
+	}{
+		{"", "batch: invalid schema: empty schema"},
+		{"{}", "batch: invalid schema at line 1: empty schema"},
+		{"- email", "batch: invalid schema at line 1: expected a mapping of field names to rules"},
+		{"email: required,emial", `batch: invalid schema for email at line 1: rule "emial": unknown rule`},
+		{"email: [required, emial]", `batch: invalid schema for email at line 1: rule "emial": unknown rule`},
+		{"name: omitempty,min=three", `batch: invalid schema for name at line 1: rule "min=three": expected a number`},
+		{"end: gtfield=start", `batch: invalid schema for end at line 1: rule "gtfield=start": unknown field start`},
+		{"email: required\nemail: email", "batch: invalid schema for email at line 2: duplicate field"},
+		{"email: {rule: email}", "batch: invalid schema for email at line 1: expected rules as a string or a list"},
+		{"email: [[email]]", "batch: invalid schema for email at line 1: expected a rule"},
+		{"email: [", "batch: invalid schema: yaml: line 1: did not find expected node content"},
+	}
+
+	for _, testCase := range testCases {
+		_, err := batch.ParseSchema([]byte(testCase.schema))
+		var schemaErr *batch.SchemaError
+		assert.True(errors.As(err, &schemaErr), testCase.schema)
+		assert.EqualError(err, testCase.err, testCase.schema)
+	}
+}
@@ -0,0 +1,38 @@
+package validator
+
+import "unsafe"
+
+//go:generate go run ./internal/genbytes
+
+// Every validator of the package taking a single string has a []byte variant
+// named after it, such as IsUUIDBytes for IsUUID, that validates fields
+// straight out of a read buffer without copying them into a string. The
+// variants are generated in bytes_gen.go.
+
+// ForBytes adapts a validator to []byte input without copying it into a
+// string, for validators taking extra arguments or defined outside of the
+// package:
+//
+//	isGermanPostalCode := validator.ForBytes(func(str string) bool {
+//		return validator.IsPostalCode(str, "DE")
+//	})
+//	isGermanPostalCode(buf[start:end])
+//
+// The string passed to fn shares memory with the byte slice: fn must not keep
+// it, or anything sliced from it, after returning, since it would change with
+// the slice. Every validator of this package satisfies this.
+func ForBytes(fn func(string) bool) func([]byte) bool {
+	return func(b []byte) bool {
+		return fn(bytesToString(b))
+	}
+}
+
+// bytesToString returns a string that shares memory with b. The string must
+// not outlive b or be used after b is modified.
+func bytesToString(b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+
+	return *(*string)(unsafe.Pointer(&b))
+}
@@ -0,0 +1,428 @@
+// Code generated by genbytes; DO NOT EDIT.
+
+package validator
+
+// HasBidiControlBytes is HasBidiControl for a byte slice, which is not copied.
+func HasBidiControlBytes(b []byte) bool {
+	return HasBidiControl(bytesToString(b))
+}
+
+// HasInvisibleCharBytes is HasInvisibleChar for a byte slice, which is not copied.
+func HasInvisibleCharBytes(b []byte) bool {
+	return HasInvisibleChar(bytesToString(b))
+}
+
+// HasMultibyteCharBytes is HasMultibyteChar for a byte slice, which is not copied.
+func HasMultibyteCharBytes(b []byte) bool {
+	return HasMultibyteChar(bytesToString(b))
+}
+
+// HasPostalCodeSystemBytes is HasPostalCodeSystem for a byte slice, which is not copied.
+func HasPostalCodeSystemBytes(b []byte) bool {
+	return HasPostalCodeSystem(bytesToString(b))
+}
+
+// IsASCIIBytes is IsASCII for a byte slice, which is not copied.
+func IsASCIIBytes(b []byte) bool {
+	return IsASCII(bytesToString(b))
+}
+
+// IsAlphaBytes is IsAlpha for a byte slice, which is not copied.
+func IsAlphaBytes(b []byte) bool {
+	return IsAlpha(bytesToString(b))
+}
+
+// IsAlphaArabicBytes is IsAlphaArabic for a byte slice, which is not copied.
+func IsAlphaArabicBytes(b []byte) bool {
+	return IsAlphaArabic(bytesToString(b))
+}
+
+// IsAlphaCyrillicBytes is IsAlphaCyrillic for a byte slice, which is not copied.
+func IsAlphaCyrillicBytes(b []byte) bool {
+	return IsAlphaCyrillic(bytesToString(b))
+}
+
+// IsAlphaDevanagariBytes is IsAlphaDevanagari for a byte slice, which is not copied.
+func IsAlphaDevanagariBytes(b []byte) bool {
+	return IsAlphaDevanagari(bytesToString(b))
+}
+
+// IsAlphaGreekBytes is IsAlphaGreek for a byte slice, which is not copied.
+func IsAlphaGreekBytes(b []byte) bool {
+	return IsAlphaGreek(bytesToString(b))
+}
+
+// IsAlphaHanBytes is IsAlphaHan for a byte slice, which is not copied.
+func IsAlphaHanBytes(b []byte) bool {
+	return IsAlphaHan(bytesToString(b))
+}
+
+// IsAlphaHangulBytes is IsAlphaHangul for a byte slice, which is not copied.
+func IsAlphaHangulBytes(b []byte) bool {
+	return IsAlphaHangul(bytesToString(b))
+}
+
+// IsAlphaHebrewBytes is IsAlphaHebrew for a byte slice, which is not copied.
+func IsAlphaHebrewBytes(b []byte) bool {
+	return IsAlphaHebrew(bytesToString(b))
+}
+
+// IsAlphaJapaneseBytes is IsAlphaJapanese for a byte slice, which is not copied.
+func IsAlphaJapaneseBytes(b []byte) bool {
+	return IsAlphaJapanese(bytesToString(b))
+}
+
+// IsAlphaLatinBytes is IsAlphaLatin for a byte slice, which is not copied.
+func IsAlphaLatinBytes(b []byte) bool {
+	return IsAlphaLatin(bytesToString(b))
+}
+
+// IsAlphaNumericBytes is IsAlphaNumeric for a byte slice, which is not copied.
+func IsAlphaNumericBytes(b []byte) bool {
+	return IsAlphaNumeric(bytesToString(b))
+}
+
+// IsAlphaUnicodeBytes is IsAlphaUnicode for a byte slice, which is not copied.
+func IsAlphaUnicodeBytes(b []byte) bool {
+	return IsAlphaUnicode(bytesToString(b))
+}
+
+// IsAlphaUnicodeNumericBytes is IsAlphaUnicodeNumeric for a byte slice, which is not copied.
+func IsAlphaUnicodeNumericBytes(b []byte) bool {
+	return IsAlphaUnicodeNumeric(bytesToString(b))
+}
+
+// IsBCP47Bytes is IsBCP47 for a byte slice, which is not copied.
+func IsBCP47Bytes(b []byte) bool {
+	return IsBCP47(bytesToString(b))
+}
+
+// IsBase64Bytes is IsBase64 for a byte slice, which is not copied.
+func IsBase64Bytes(b []byte) bool {
+	return IsBase64(bytesToString(b))
+}
+
+// IsBase64URLBytes is IsBase64URL for a byte slice, which is not copied.
+func IsBase64URLBytes(b []byte) bool {
+	return IsBase64URL(bytesToString(b))
+}
+
+// IsBigFloatBytes is IsBigFloat for a byte slice, which is not copied.
+func IsBigFloatBytes(b []byte) bool {
+	return IsBigFloat(bytesToString(b))
+}
+
+// IsBigIntBytes is IsBigInt for a byte slice, which is not copied.
+func IsBigIntBytes(b []byte) bool {
+	return IsBigInt(bytesToString(b))
+}
+
+// IsBoundingBoxBytes is IsBoundingBox for a byte slice, which is not copied.
+func IsBoundingBoxBytes(b []byte) bool {
+	return IsBoundingBox(bytesToString(b))
+}
+
+// IsCronBytes is IsCron for a byte slice, which is not copied.
+func IsCronBytes(b []byte) bool {
+	return IsCron(bytesToString(b))
+}
+
+// IsDMSBytes is IsDMS for a byte slice, which is not copied.
+func IsDMSBytes(b []byte) bool {
+	return IsDMS(bytesToString(b))
+}
+
+// IsDataURIBytes is IsDataURI for a byte slice, which is not copied.
+func IsDataURIBytes(b []byte) bool {
+	return IsDataURI(bytesToString(b))
+}
+
+// IsDomainNameBytes is IsDomainName for a byte slice, which is not copied.
+func IsDomainNameBytes(b []byte) bool {
+	return IsDomainName(bytesToString(b))
+}
+
+// IsDoubleHTMLEncodedBytes is IsDoubleHTMLEncoded for a byte slice, which is not copied.
+func IsDoubleHTMLEncodedBytes(b []byte) bool {
+	return IsDoubleHTMLEncoded(bytesToString(b))
+}
+
+// IsDoublePercentEncodedBytes is IsDoublePercentEncoded for a byte slice, which is not copied.
+func IsDoublePercentEncodedBytes(b []byte) bool {
+	return IsDoublePercentEncoded(bytesToString(b))
+}
+
+// IsETHAddressBytes is IsETHAddress for a byte slice, which is not copied.
+func IsETHAddressBytes(b []byte) bool {
+	return IsETHAddress(bytesToString(b))
+}
+
+// IsEmailBytes is IsEmail for a byte slice, which is not copied.
+func IsEmailBytes(b []byte) bool {
+	return IsEmail(bytesToString(b))
+}
+
+// IsEmptyBytes is IsEmpty for a byte slice, which is not copied.
+func IsEmptyBytes(b []byte) bool {
+	return IsEmpty(bytesToString(b))
+}
+
+// IsGoDurationBytes is IsGoDuration for a byte slice, which is not copied.
+func IsGoDurationBytes(b []byte) bool {
+	return IsGoDuration(bytesToString(b))
+}
+
+// IsGoModuleVersionBytes is IsGoModuleVersion for a byte slice, which is not copied.
+func IsGoModuleVersionBytes(b []byte) bool {
+	return IsGoModuleVersion(bytesToString(b))
+}
+
+// IsHSLBytes is IsHSL for a byte slice, which is not copied.
+func IsHSLBytes(b []byte) bool {
+	return IsHSL(bytesToString(b))
+}
+
+// IsHSLABytes is IsHSLA for a byte slice, which is not copied.
+func IsHSLABytes(b []byte) bool {
+	return IsHSLA(bytesToString(b))
+}
+
+// IsHTMLBytes is IsHTML for a byte slice, which is not copied.
+func IsHTMLBytes(b []byte) bool {
+	return IsHTML(bytesToString(b))
+}
+
+// IsHTMLEncodedBytes is IsHTMLEncoded for a byte slice, which is not copied.
+func IsHTMLEncodedBytes(b []byte) bool {
+	return IsHTMLEncoded(bytesToString(b))
+}
+
+// IsHTMLEntityEncodedBytes is IsHTMLEntityEncoded for a byte slice, which is not copied.
+func IsHTMLEntityEncodedBytes(b []byte) bool {
+	return IsHTMLEntityEncoded(bytesToString(b))
+}
+
+// IsHexadecimalBytes is IsHexadecimal for a byte slice, which is not copied.
+func IsHexadecimalBytes(b []byte) bool {
+	return IsHexadecimal(bytesToString(b))
+}
+
+// IsHexcolorBytes is IsHexcolor for a byte slice, which is not copied.
+func IsHexcolorBytes(b []byte) bool {
+	return IsHexcolor(bytesToString(b))
+}
+
+// IsIANATimezoneBytes is IsIANATimezone for a byte slice, which is not copied.
+func IsIANATimezoneBytes(b []byte) bool {
+	return IsIANATimezone(bytesToString(b))
+}
+
+// IsIBANBytes is IsIBAN for a byte slice, which is not copied.
+func IsIBANBytes(b []byte) bool {
+	return IsIBAN(bytesToString(b))
+}
+
+// IsIPAddressBytes is IsIPAddress for a byte slice, which is not copied.
+func IsIPAddressBytes(b []byte) bool {
+	return IsIPAddress(bytesToString(b))
+}
+
+// IsISBN10Bytes is IsISBN10 for a byte slice, which is not copied.
+func IsISBN10Bytes(b []byte) bool {
+	return IsISBN10(bytesToString(b))
+}
+
+// IsISBN13Bytes is IsISBN13 for a byte slice, which is not copied.
+func IsISBN13Bytes(b []byte) bool {
+	return IsISBN13(bytesToString(b))
+}
+
+// IsISO15924Bytes is IsISO15924 for a byte slice, which is not copied.
+func IsISO15924Bytes(b []byte) bool {
+	return IsISO15924(bytesToString(b))
+}
+
+// IsISO3166Alpha2Bytes is IsISO3166Alpha2 for a byte slice, which is not copied.
+func IsISO3166Alpha2Bytes(b []byte) bool {
+	return IsISO3166Alpha2(bytesToString(b))
+}
+
+// IsISO3166Alpha3Bytes is IsISO3166Alpha3 for a byte slice, which is not copied.
+func IsISO3166Alpha3Bytes(b []byte) bool {
+	return IsISO3166Alpha3(bytesToString(b))
+}
+
+// IsISO3166NumericBytes is IsISO3166Numeric for a byte slice, which is not copied.
+func IsISO3166NumericBytes(b []byte) bool {
+	return IsISO3166Numeric(bytesToString(b))
+}
+
+// IsISO4217Bytes is IsISO4217 for a byte slice, which is not copied.
+func IsISO4217Bytes(b []byte) bool {
+	return IsISO4217(bytesToString(b))
+}
+
+// IsISO4217NumericBytes is IsISO4217Numeric for a byte slice, which is not copied.
+func IsISO4217NumericBytes(b []byte) bool {
+	return IsISO4217Numeric(bytesToString(b))
+}
+
+// IsISO639Bytes is IsISO639 for a byte slice, which is not copied.
+func IsISO639Bytes(b []byte) bool {
+	return IsISO639(bytesToString(b))
+}
+
+// IsISO639Alpha2Bytes is IsISO639Alpha2 for a byte slice, which is not copied.
+func IsISO639Alpha2Bytes(b []byte) bool {
+	return IsISO639Alpha2(bytesToString(b))
+}
+
+// IsISO639Alpha3Bytes is IsISO639Alpha3 for a byte slice, which is not copied.
+func IsISO639Alpha3Bytes(b []byte) bool {
+	return IsISO639Alpha3(bytesToString(b))
+}
+
+// IsISO8601Bytes is IsISO8601 for a byte slice, which is not copied.
+func IsISO8601Bytes(b []byte) bool {
+	return IsISO8601(bytesToString(b))
+}
+
+// IsISO8601DurationBytes is IsISO8601Duration for a byte slice, which is not copied.
+func IsISO8601DurationBytes(b []byte) bool {
+	return IsISO8601Duration(bytesToString(b))
+}
+
+// IsLatitudeBytes is IsLatitude for a byte slice, which is not copied.
+func IsLatitudeBytes(b []byte) bool {
+	return IsLatitude(bytesToString(b))
+}
+
+// IsLongitudeBytes is IsLongitude for a byte slice, which is not copied.
+func IsLongitudeBytes(b []byte) bool {
+	return IsLongitude(bytesToString(b))
+}
+
+// IsMixedScriptBytes is IsMixedScript for a byte slice, which is not copied.
+func IsMixedScriptBytes(b []byte) bool {
+	return IsMixedScript(bytesToString(b))
+}
+
+// IsNFCBytes is IsNFC for a byte slice, which is not copied.
+func IsNFCBytes(b []byte) bool {
+	return IsNFC(bytesToString(b))
+}
+
+// IsNFKCBytes is IsNFKC for a byte slice, which is not copied.
+func IsNFKCBytes(b []byte) bool {
+	return IsNFKC(bytesToString(b))
+}
+
+// IsNumberBytes is IsNumber for a byte slice, which is not copied.
+func IsNumberBytes(b []byte) bool {
+	return IsNumber(bytesToString(b))
+}
+
+// IsNumericBytes is IsNumeric for a byte slice, which is not copied.
+func IsNumericBytes(b []byte) bool {
+	return IsNumeric(bytesToString(b))
+}
+
+// IsPercentEncodedBytes is IsPercentEncoded for a byte slice, which is not copied.
+func IsPercentEncodedBytes(b []byte) bool {
+	return IsPercentEncoded(bytesToString(b))
+}
+
+// IsPrintableASCIIBytes is IsPrintableASCII for a byte slice, which is not copied.
+func IsPrintableASCIIBytes(b []byte) bool {
+	return IsPrintableASCII(bytesToString(b))
+}
+
+// IsRFC3339Bytes is IsRFC3339 for a byte slice, which is not copied.
+func IsRFC3339Bytes(b []byte) bool {
+	return IsRFC3339(bytesToString(b))
+}
+
+// IsRFC3339NanoBytes is IsRFC3339Nano for a byte slice, which is not copied.
+func IsRFC3339NanoBytes(b []byte) bool {
+	return IsRFC3339Nano(bytesToString(b))
+}
+
+// IsRGBBytes is IsRGB for a byte slice, which is not copied.
+func IsRGBBytes(b []byte) bool {
+	return IsRGB(bytesToString(b))
+}
+
+// IsRGBABytes is IsRGBA for a byte slice, which is not copied.
+func IsRGBABytes(b []byte) bool {
+	return IsRGBA(bytesToString(b))
+}
+
+// IsRRuleBytes is IsRRule for a byte slice, which is not copied.
+func IsRRuleBytes(b []byte) bool {
+	return IsRRule(bytesToString(b))
+}
+
+// IsSemVerBytes is IsSemVer for a byte slice, which is not copied.
+func IsSemVerBytes(b []byte) bool {
+	return IsSemVer(bytesToString(b))
+}
+
+// IsSemVerConstraintBytes is IsSemVerConstraint for a byte slice, which is not copied.
+func IsSemVerConstraintBytes(b []byte) bool {
+	return IsSemVerConstraint(bytesToString(b))
+}
+
+// IsSingleScriptBytes is IsSingleScript for a byte slice, which is not copied.
+func IsSingleScriptBytes(b []byte) bool {
+	return IsSingleScript(bytesToString(b))
+}
+
+// IsURLEncodedBytes is IsURLEncoded for a byte slice, which is not copied.
+func IsURLEncodedBytes(b []byte) bool {
+	return IsURLEncoded(bytesToString(b))
+}
+
+// IsUUIDBytes is IsUUID for a byte slice, which is not copied.
+func IsUUIDBytes(b []byte) bool {
+	return IsUUID(bytesToString(b))
+}
+
+// IsUUID3Bytes is IsUUID3 for a byte slice, which is not copied.
+func IsUUID3Bytes(b []byte) bool {
+	return IsUUID3(bytesToString(b))
+}
+
+// IsUUID3MixedBytes is IsUUID3Mixed for a byte slice, which is not copied.
+func IsUUID3MixedBytes(b []byte) bool {
+	return IsUUID3Mixed(bytesToString(b))
+}
+
+// IsUUID4Bytes is IsUUID4 for a byte slice, which is not copied.
+func IsUUID4Bytes(b []byte) bool {
+	return IsUUID4(bytesToString(b))
+}
+
+// IsUUID4MixedBytes is IsUUID4Mixed for a byte slice, which is not copied.
+func IsUUID4MixedBytes(b []byte) bool {
+	return IsUUID4Mixed(bytesToString(b))
+}
+
+// IsUUID5Bytes is IsUUID5 for a byte slice, which is not copied.
+func IsUUID5Bytes(b []byte) bool {
+	return IsUUID5(bytesToString(b))
+}
+
+// IsUUID5MixedBytes is IsUUID5Mixed for a byte slice, which is not copied.
+func IsUUID5MixedBytes(b []byte) bool {
+	return IsUUID5Mixed(bytesToString(b))
+}
+
+// IsUUIDMixedBytes is IsUUIDMixed for a byte slice, which is not copied.
+func IsUUIDMixedBytes(b []byte) bool {
+	return IsUUIDMixed(bytesToString(b))
+}
+
+// IsValidUTF8Bytes is IsValidUTF8 for a byte slice, which is not copied.
+func IsValidUTF8Bytes(b []byte) bool {
+	return IsValidUTF8(bytesToString(b))
+}
@@ -0,0 +1,42 @@
+package validator_test
+
+import (
+	validator "github.com/MrWormHole/simple-validator"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestForBytes(t *testing.T) {
+	assert := assert.New(t)
+
+	isUUID := validator.ForBytes(validator.IsUUID)
+	buf := []byte("id=a987fbc9-4bed-3078-cf07-9141ba07c9f3;")
+	assert.True(isUUID(buf[3 : len(buf)-1]))
+	assert.False(isUUID(buf))
+	assert.False(isUUID(nil))
+
+	isEmpty := validator.ForBytes(validator.IsEmpty)
+	assert.True(isEmpty(nil))
+	assert.True(isEmpty([]byte{}))
+
+	isGermanPostalCode := validator.ForBytes(func(str string) bool {
+		return validator.IsPostalCode(str, "DE")
+	})
+	assert.True(isGermanPostalCode([]byte("10115")))
+
+	isASCII := validator.ForBytes(validator.IsASCII)
+	assert.Equal(0.0, testing.AllocsPerRun(100, func() { isASCII(buf) }))
+}
+
+func TestBytesVariants(t *testing.T) {
+	assert := assert.New(t)
+
+	buf := []byte("id=a987fbc9-4bed-3078-cf07-9141ba07c9f3;")
+	assert.True(validator.IsUUIDBytes(buf[3 : len(buf)-1]))
+	assert.False(validator.IsUUIDBytes(buf))
+	assert.True(validator.IsEmailBytes([]byte("john.doe@example.com")))
+	assert.True(validator.IsEmptyBytes(nil))
+	assert.True(validator.HasMultibyteCharBytes([]byte("héllo")))
+	assert.True(validator.IsISO3166Alpha2Bytes([]byte("DE")))
+	assert.Equal(0.0, testing.AllocsPerRun(100, func() { validator.IsASCIIBytes(buf) }))
+}
@@ -0,0 +1,316 @@
+// Command simple-validator validates values from the command line or from
+// standard input with the validators of the package, for use in shell
+// pipelines:
+//
+//	simple-validator email john.doe@example.com
+//	simple-validator -invert uuid4 < ids.txt
+//	simple-validator -rule email -column 3 -header < users.csv
+//
+// The rule is the name of an Is* validator taking a single string, without Is
+// and in any case, such as email, uuid4, isbn13 or iso3166_alpha2; -list
+// prints them all. Values are taken from the arguments after the rule or, when
+// there are none, from the lines of standard input.
+//
+// By default the valid values are printed, like grep, and -invert prints the
+// invalid ones instead. With -column, the input is read as CSV, or as TSV with
+// -format tsv, and whole records are printed. -json reports every value as a
+// JSON object with its line number, validity and the reason it is invalid,
+// or only the invalid ones with -invert.
+//
+// The exit status is 0 when every value is valid, 1 when some value is
+// invalid and 2 on usage or input errors.
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	validator "github.com/MrWormHole/simple-validator"
+)
+
+const (
+	exitValid   = 0
+	exitInvalid = 1
+	exitError   = 2
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+type options struct {
+	rule      string
+	invert    bool
+	json      bool
+	quiet     bool
+	column    int
+	format    string
+	header    bool
+	maxLength int
+}
+
+// result is the JSON report of a value.
+type result struct {
+	Line   int    `json:"line"`
+	Value  string `json:"value"`
+	Valid  bool   `json:"valid"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// record is a value to validate and the input it was read from.
+type record struct {
+	line   int
+	fields []string
+	value  string
+	// missing is set when the record has no field at the selected column.
+	missing bool
+}
+
+func run(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	flags := flag.NewFlagSet("simple-validator", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "usage: simple-validator [flags] rule [value...]\n       simple-validator [flags] -rule rule [value...]\n")
+		flags.PrintDefaults()
+	}
+
+	var opts options
+	list := flags.Bool("list", false, "list the available rules")
+	flags.StringVar(&opts.rule, "rule", "", "rule to validate with, instead of the first argument")
+	flags.BoolVar(&opts.invert, "invert", false, "print the invalid values instead of the valid ones")
+	flags.BoolVar(&opts.json, "json", false, "report every value as a JSON object, with the reason it is invalid")
+	flags.BoolVar(&opts.quiet, "quiet", false, "print nothing, only set the exit status")
+	flags.IntVar(&opts.column, "column", 0, "validate the `n`th field of CSV or TSV records, from 1")
+	flags.StringVar(&opts.format, "format", "", "input format: lines, csv or tsv; csv by default with -column")
+	flags.BoolVar(&opts.header, "header", false, "skip the first record of the input and print it unchanged")
+	flags.IntVar(&opts.maxLength, "max-length", 0, "reject values longer than `n` bytes without validating them")
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitValid
+		}
+		return exitError
+	}
+
+	if *list {
+		names := make([]string, 0, len(rules))
+		for name := range rules {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		fmt.Fprintln(stdout, strings.Join(names, "\n"))
+		return exitValid
+	}
+
+	values := flags.Args()
+	if opts.rule == "" {
+		if len(values) == 0 {
+			flags.Usage()
+			return exitError
+		}
+		opts.rule, values = values[0], values[1:]
+	}
+	name := ruleName(opts.rule)
+	fn, ok := rules[name]
+	if !ok {
+		fmt.Fprintf(stderr, "simple-validator: unknown rule %q; run with -list to see the rules\n", opts.rule)
+		return exitError
+	}
+
+	if opts.format == "" {
+		opts.format = "lines"
+		if opts.column > 0 {
+			opts.format = "csv"
+		}
+	}
+	if opts.format != "lines" && opts.format != "csv" && opts.format != "tsv" {
+		fmt.Fprintf(stderr, "simple-validator: unknown format %q\n", opts.format)
+		return exitError
+	}
+	if opts.format != "lines" && opts.column < 1 {
+		fmt.Fprintf(stderr, "simple-validator: -format %s needs -column\n", opts.format)
+		return exitError
+	}
+	if len(values) > 0 && (opts.column > 0 || opts.header) {
+		fmt.Fprintln(stderr, "simple-validator: -column and -header apply to standard input only")
+		return exitError
+	}
+
+	check := validator.WithMaxLength(opts.maxLength, fn)
+	out := newOutput(stdout, opts)
+	status := exitValid
+	err := readRecords(values, stdin, opts, func(r record, header bool) error {
+		if header {
+			return out.header(r)
+		}
+
+		valid, reason := validate(name, check, r, opts)
+		if !valid {
+			status = exitInvalid
+		}
+		return out.write(r, valid, reason)
+	})
+	if err == nil {
+		err = out.flush()
+	}
+	if err != nil {
+		fmt.Fprintln(stderr, "simple-validator:", err)
+		return exitError
+	}
+
+	return status
+}
+
+// validate checks the value of a record and returns the reason it is invalid.
+func validate(name string, check func(string) error, r record, opts options) (bool, string) {
+	if r.missing {
+		return false, "missing column " + strconv.Itoa(opts.column)
+	}
+
+	err := check(r.value)
+	switch {
+	case err == nil:
+		return true, ""
+	case errors.Is(err, validator.ErrTooLong):
+		return false, err.Error()
+	}
+
+	if explain, ok := reasons[name]; ok {
+		if err := explain(r.value); err != nil {
+			return false, err.Error()
+		}
+	}
+
+	return false, "not a valid " + name
+}
+
+// readRecords calls fn with the values given as arguments or, when there are
+// none, with the records of stdin.
+func readRecords(values []string, stdin io.Reader, opts options, fn func(r record, header bool) error) error {
+	if len(values) > 0 {
+		for i, value := range values {
+			if err := fn(record{line: i + 1, value: value}, false); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if opts.format == "lines" {
+		reader := bufio.NewReader(stdin)
+		for line := 1; ; line++ {
+			text, err := reader.ReadString('\n')
+			if text == "" && errors.Is(err, io.EOF) {
+				return nil
+			}
+			if err != nil && !errors.Is(err, io.EOF) {
+				return err
+			}
+
+			text = strings.TrimSuffix(strings.TrimSuffix(text, "\n"), "\r")
+			if err := fn(record{line: line, value: text}, opts.header && line == 1); err != nil {
+				return err
+			}
+		}
+	}
+
+	reader := csv.NewReader(stdin)
+	reader.FieldsPerRecord = -1
+	if opts.format == "tsv" {
+		reader.Comma = '\t'
+		reader.LazyQuotes = true
+	}
+	for first := true; ; first = false {
+		fields, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		line, _ := reader.FieldPos(0)
+		r := record{line: line, fields: fields, missing: opts.column > len(fields)}
+		if !r.missing {
+			r.value = fields[opts.column-1]
+		}
+		if err := fn(r, opts.header && first); err != nil {
+			return err
+		}
+	}
+}
+
+// output prints the records selected by the options.
+type output struct {
+	opts    options
+	writer  *bufio.Writer
+	csv     *csv.Writer
+	encoder *json.Encoder
+}
+
+func newOutput(stdout io.Writer, opts options) *output {
+	out := &output{opts: opts, writer: bufio.NewWriter(stdout)}
+	if opts.json {
+		out.encoder = json.NewEncoder(out.writer)
+		out.encoder.SetEscapeHTML(false)
+	} else if opts.format != "lines" {
+		out.csv = csv.NewWriter(out.writer)
+		if opts.format == "tsv" {
+			out.csv.Comma = '\t'
+		}
+	}
+
+	return out
+}
+
+func (out *output) header(r record) error {
+	if out.opts.quiet || out.opts.json {
+		return nil
+	}
+
+	return out.print(r)
+}
+
+func (out *output) write(r record, valid bool, reason string) error {
+	switch {
+	case out.opts.quiet:
+		return nil
+	case out.opts.json:
+		if out.opts.invert && valid {
+			return nil
+		}
+		return out.encoder.Encode(result{Line: r.line, Value: r.value, Valid: valid, Reason: reason})
+	case valid == out.opts.invert:
+		return nil
+	}
+
+	return out.print(r)
+}
+
+func (out *output) print(r record) error {
+	if out.csv != nil {
+		return out.csv.Write(r.fields)
+	}
+
+	_, err := fmt.Fprintln(out.writer, r.value)
+	return err
+}
+
+func (out *output) flush() error {
+	if out.csv != nil {
+		out.csv.Flush()
+		if err := out.csv.Error(); err != nil {
+			return err
+		}
+	}
+
+	return out.writer.Flush()
+}
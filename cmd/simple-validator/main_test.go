@@ -0,0 +1,121 @@
+package main
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func runCommand(stdin string, args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+	status := run(args, strings.NewReader(stdin), &stdout, &stderr)
+
+	return status, stdout.String(), stderr.String()
+}
+
+func TestRunArguments(t *testing.T) {
+	assert := assert.New(t)
+
+	status, stdout, _ := runCommand("", "email", "john.doe@example.com")
+	assert.Equal(exitValid, status)
+	assert.Equal("john.doe@example.com\n", stdout)
+
+	status, stdout, _ = runCommand("", "Email", "john.doe@example.com", "john.doe@")
+	assert.Equal(exitInvalid, status)
+	assert.Equal("john.doe@example.com\n", stdout)
+
+	status, stdout, _ = runCommand("", "-invert", "-rule", "IsUUID4", "57b73598-8764-4ad0-a76a-679bb6640eb1", "x")
+	assert.Equal(exitInvalid, status)
+	assert.Equal("x\n", stdout)
+
+	status, stdout, _ = runCommand("", "-quiet", "iso3166_alpha2", "DE", "XX")
+	assert.Equal(exitInvalid, status)
+	assert.Equal("", stdout)
+
+	status, stdout, _ = runCommand("", "isbn13")
+	assert.Equal(exitValid, status)
+	assert.Equal("", stdout)
+}
+
+func TestRunLines(t *testing.T) {
+	assert := assert.New(t)
+
+	status, stdout, _ := runCommand("9780306406157\r\n978030640615\n\n9780306406157", "isbn13")
+	assert.Equal(exitInvalid, status)
+	assert.Equal("9780306406157\n9780306406157\n", stdout)
+
+	status, stdout, _ = runCommand("9780306406157\r\n978030640615\n\n9780306406157", "-invert", "isbn13")
+	assert.Equal(exitInvalid, status)
+	assert.Equal("978030640615\n\n", stdout)
+
+	status, stdout, _ = runCommand("id\n42\n", "-header", "number")
+	assert.Equal(exitValid, status)
+	assert.Equal("id\n42\n", stdout)
+}
+
+func TestRunJSON(t *testing.T) {
+	assert := assert.New(t)
+
+	status, stdout, _ := runCommand("1.2.3\n1.2\n", "-json", "semver")
+	assert.Equal(exitInvalid, status)
+	assert.Equal(`{"line":1,"value":"1.2.3","valid":true}
+{"line":2,"value":"1.2","valid":false,"reason":"invalid semantic version"}
+`, stdout)
+
+	status, stdout, _ = runCommand("a@b.co\n<script>\n", "-json", "-invert", "email")
+	assert.Equal(exitInvalid, status)
+	assert.Equal(`{"line":2,"value":"<script>","valid":false,"reason":"not a valid email"}
+`, stdout)
+
+	status, stdout, _ = runCommand("", "-json", "-max-length", "5", "alpha", "abcdef")
+	assert.Equal(exitInvalid, status)
+	assert.Equal(`{"line":1,"value":"abcdef","valid":false,"reason":"input too long"}
+`, stdout)
+}
+
+func TestRunColumns(t *testing.T) {
+	assert := assert.New(t)
+
+	csv := "id,name,email\n1,\"Doe, John\",john.doe@example.com\n2,Jane,jane@\n3,Bob\n"
+
+	status, stdout, _ := runCommand(csv, "-column", "3", "-header", "-rule", "email")
+	assert.Equal(exitInvalid, status)
+	assert.Equal("id,name,email\n1,\"Doe, John\",john.doe@example.com\n", stdout)
+
+	status, stdout, _ = runCommand(csv, "-column", "3", "-header", "-json", "-invert", "email")
+	assert.Equal(exitInvalid, status)
+	assert.Equal(`{"line":3,"value":"jane@","valid":false,"reason":"not a valid email"}
+{"line":4,"value":"","valid":false,"reason":"missing column 3"}
+`, stdout)
+
+	status, stdout, _ = runCommand("a\t57b73598-8764-4ad0-a76a-679bb6640eb1\nb\tx\n", "-format", "tsv", "-column", "2", "-invert", "uuid")
+	assert.Equal(exitInvalid, status)
+	assert.Equal("b\tx\n", stdout)
+}
+
+func TestRunErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	status, _, stderr := runCommand("", "bogus", "x")
+	assert.Equal(exitError, status)
+	assert.Contains(stderr, `unknown rule "bogus"`)
+
+	status, _, _ = runCommand("")
+	assert.Equal(exitError, status)
+
+	status, _, stderr = runCommand("", "-format", "xml", "email")
+	assert.Equal(exitError, status)
+	assert.Contains(stderr, `unknown format "xml"`)
+
+	status, _, _ = runCommand("", "-column", "2", "email", "x")
+	assert.Equal(exitError, status)
+
+	status, _, stderr = runCommand("a,\"b\n", "-column", "1", "email")
+	assert.Equal(exitError, status)
+	assert.Contains(stderr, "extraneous or missing \" in quoted-field")
+
+	status, stdout, _ := runCommand("", "-list")
+	assert.Equal(exitValid, status)
+	assert.Contains(stdout, "\nemail\n")
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+
+	validator "github.com/MrWormHole/simple-validator"
+)
+
+// rules maps rule names to the Is* validators of the package taking a single
+// string. Names are the function names without Is in lower case; see
+// ruleName for the spellings accepted on the command line.
+var rules = map[string]func(string) bool{
+	"ascii":                validator.IsASCII,
+	"alpha":                validator.IsAlpha,
+	"alphaarabic":          validator.IsAlphaArabic,
+	"alphacyrillic":        validator.IsAlphaCyrillic,
+	"alphadevanagari":      validator.IsAlphaDevanagari,
+	"alphagreek":           validator.IsAlphaGreek,
+	"alphahan":             validator.IsAlphaHan,
+	"alphahangul":          validator.IsAlphaHangul,
+	"alphahebrew":          validator.IsAlphaHebrew,
+	"alphajapanese":        validator.IsAlphaJapanese,
+	"alphalatin":           validator.IsAlphaLatin,
+	"alphanumeric":         validator.IsAlphaNumeric,
+	"alphaunicode":         validator.IsAlphaUnicode,
+	"alphaunicodenumeric":  validator.IsAlphaUnicodeNumeric,
+	"bcp47":                validator.IsBCP47,
+	"base64":               validator.IsBase64,
+	"base64url":            validator.IsBase64URL,
+	"bigfloat":             validator.IsBigFloat,
+	"bigint":               validator.IsBigInt,
+	"boundingbox":          validator.IsBoundingBox,
+	"cron":                 validator.IsCron,
+	"dms":                  validator.IsDMS,
+	"datauri":              validator.IsDataURI,
+	"domainname":           validator.IsDomainName,
+	"doublehtmlencoded":    validator.IsDoubleHTMLEncoded,
+	"doublepercentencoded": validator.IsDoublePercentEncoded,
+	"ethaddress":           validator.IsETHAddress,
+	"email":                validator.IsEmail,
+	"empty":                validator.IsEmpty,
+	"goduration":           validator.IsGoDuration,
+	"gomoduleversion":      validator.IsGoModuleVersion,
+	"hsl":                  validator.IsHSL,
+	"hsla":                 validator.IsHSLA,
+	"html":                 validator.IsHTML,
+	"htmlencoded":          validator.IsHTMLEncoded,
+	"htmlentityencoded":    validator.IsHTMLEntityEncoded,
+	"hexadecimal":          validator.IsHexadecimal,
+	"hexcolor":             validator.IsHexcolor,
+	"ianatimezone":         validator.IsIANATimezone,
+	"iban":                 validator.IsIBAN,
+	"ipaddress":            validator.IsIPAddress,
+	"isbn10":               validator.IsISBN10,
+	"isbn13":               validator.IsISBN13,
+	"iso15924":             validator.IsISO15924,
+	"iso3166alpha2":        validator.IsISO3166Alpha2,
+	"iso3166alpha3":        validator.IsISO3166Alpha3,
+	"iso3166numeric":       validator.IsISO3166Numeric,
+	"iso4217":              validator.IsISO4217,
+	"iso4217numeric":       validator.IsISO4217Numeric,
+	"iso639":               validator.IsISO639,
+	"iso639alpha2":         validator.IsISO639Alpha2,
+	"iso639alpha3":         validator.IsISO639Alpha3,
+	"iso8601":              validator.IsISO8601,
+	"iso8601duration":      validator.IsISO8601Duration,
+	"latitude":             validator.IsLatitude,
+	"longitude":            validator.IsLongitude,
+	"mixedscript":          validator.IsMixedScript,
+	"nfc":                  validator.IsNFC,
+	"nfkc":                 validator.IsNFKC,
+	"number":               validator.IsNumber,
+	"numeric":              validator.IsNumeric,
+	"percentencoded":       validator.IsPercentEncoded,
+	"printableascii":       validator.IsPrintableASCII,
+	"rfc3339":              validator.IsRFC3339,
+	"rfc3339nano":          validator.IsRFC3339Nano,
+	"rgb":                  validator.IsRGB,
+	"rgba":                 validator.IsRGBA,
+	"rrule":                validator.IsRRule,
+	"semver":               validator.IsSemVer,
+	"semverconstraint":     validator.IsSemVerConstraint,
+	"singlescript":         validator.IsSingleScript,
+	"urlencoded":           validator.IsURLEncoded,
+	"uuid":                 validator.IsUUID,
+	"uuid3":                validator.IsUUID3,
+	"uuid3mixed":           validator.IsUUID3Mixed,
+	"uuid4":                validator.IsUUID4,
+	"uuid4mixed":           validator.IsUUID4Mixed,
+	"uuid5":                validator.IsUUID5,
+	"uuid5mixed":           validator.IsUUID5Mixed,
+	"uuidmixed":            validator.IsUUIDMixed,
+	"validutf8":            validator.IsValidUTF8,
+}
+
+// aliases maps the names used by validate struct tags to rule names, as found
+// by matching the functions of validator.Validations with those of rules.
+var aliases = tagAliases(validator.Validations())
+
+// tagAliases returns the normalized names of the validations in fns that
+// differ from the rule names of their functions.
+func tagAliases(fns map[string]func(string) bool) map[string]string {
+	names := make(map[uintptr]string, len(rules))
+	for name, fn := range rules {
+		names[reflect.ValueOf(fn).Pointer()] = name
+	}
+
+	aliases := map[string]string{}
+	for tag, fn := range fns {
+		name, ok := names[reflect.ValueOf(fn).Pointer()]
+		if alias := normalize(tag); ok && alias != name {
+			aliases[alias] = name
+		}
+	}
+
+	return aliases
+}
+
+// reasons maps rule names to the validators of the package that explain why
+// an input is invalid.
+var reasons = map[string]func(string) error{
+	"bcp47":             func(str string) error { _, err := validator.ParseBCP47(str); return err },
+	"cron":              validator.ValidateCron,
+	"dms":               func(str string) error { _, err := validator.ParseDMS(str); return err },
+	"htmlentityencoded": func(str string) error { _, err := validator.DecodeHTMLEntities(str); return err },
+	"percentencoded":    func(str string) error { _, err := validator.DecodePercent(str); return err },
+	"rrule":             validator.ValidateRRule,
+	"semver":            func(str string) error { _, err := validator.ParseSemVer(str); return err },
+	"semverconstraint":  func(str string) error { _, err := validator.ParseSemVerConstraint(str); return err },
+}
+
+// ruleName normalizes a rule name given on the command line, so that email,
+// Email, IsEmail, iso3166_alpha2 and ISO3166-Alpha2 are all accepted.
+func ruleName(name string) string {
+	name = normalize(name)
+	if _, ok := rules[name]; !ok && strings.HasPrefix(name, "is") {
+		name = name[2:]
+	}
+	if alias, ok := aliases[name]; ok {
+		return alias
+	}
+
+	return name
+}
+
+func normalize(name string) string {
+	return strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(name))
+}
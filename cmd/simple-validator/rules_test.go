@@ -0,0 +1,97 @@
+package main
+
+import (
+	validator "github.com/MrWormHole/simple-validator"
+	"github.com/stretchr/testify/assert"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/fs"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestRulesCoverValidators checks that every exported Is* function of the
+// package taking a single string has a rule.
+func TestRulesCoverValidators(t *testing.T) {
+	assert := assert.New(t)
+
+	packages, err := parser.ParseDir(token.NewFileSet(), "../..", func(info fs.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	assert.NoError(err)
+
+	count := 0
+	for _, pkg := range packages {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				fn, ok := decl.(*ast.FuncDecl)
+				if !ok || fn.Recv != nil || !strings.HasPrefix(fn.Name.Name, "Is") || !ast.IsExported(fn.Name.Name) {
+					continue
+				}
+				params, results := fn.Type.Params.List, fn.Type.Results
+				if len(params) != 1 || len(params[0].Names) != 1 || types.ExprString(params[0].Type) != "string" ||
+					results == nil || len(results.List) != 1 || types.ExprString(results.List[0].Type) != "bool" {
+					continue
+				}
+
+				count++
+				_, ok = rules[ruleName(fn.Name.Name)]
+				assert.True(ok, fn.Name.Name)
+			}
+		}
+	}
+	assert.Equal(len(rules), count)
+}
+
+// TestRulesMatchValidations checks that the names of validate struct tags
+// select the same functions as rules, and that every rule implementing a
+// validation is reached by its tag name.
+func TestRulesMatchValidations(t *testing.T) {
+	assert := assert.New(t)
+
+	names := map[uintptr]string{}
+	for name, fn := range rules {
+		pointer := reflect.ValueOf(fn).Pointer()
+		assert.NotContains(names, pointer, name)
+		names[pointer] = name
+	}
+
+	tags := map[uintptr]string{}
+	for tag, fn := range validator.Validations() {
+		name := ruleName(tag)
+		if assert.Contains(rules, name, tag) {
+			assert.Equal(reflect.ValueOf(fn).Pointer(), reflect.ValueOf(rules[name]).Pointer(), tag)
+		}
+		tags[reflect.ValueOf(fn).Pointer()] = tag
+	}
+	for pointer, name := range names {
+		if tag, ok := tags[pointer]; ok {
+			assert.Equal(name, ruleName(tag), name)
+		}
+	}
+
+	assert.Empty(tagAliases(map[string]func(string) bool{"custom": func(string) bool { return true }}))
+}
+
+func TestRuleName(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("email", ruleName("email"))
+	assert.Equal("email", ruleName("IsEmail"))
+	assert.Equal("iso3166alpha2", ruleName("iso3166_alpha2"))
+	assert.Equal("iso3166alpha2", ruleName("ISO3166-Alpha2"))
+	assert.Equal("alphanumeric", ruleName("alphanum"))
+	assert.Equal("ipaddress", ruleName("ip"))
+
+	for alias, name := range aliases {
+		_, ok := rules[name]
+		assert.True(ok, alias)
+	}
+	for name := range reasons {
+		_, ok := rules[name]
+		assert.True(ok, name)
+	}
+}
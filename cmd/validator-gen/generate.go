@@ -0,0 +1,1203 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+
+	validator "github.com/MrWormHole/simple-validator"
+)
+
+// validations maps the validation names of validator.ValidateStruct to the
+// functions implementing them, as found by validator.Validations.
+var validations = packageFunctions(validator.Validations())
+
+// packageFunctions returns the names of the exported functions of the
+// validator package among fns.
+func packageFunctions(fns map[string]func(string) bool) map[string]string {
+	prefix := reflect.TypeOf(validator.FieldError{}).PkgPath() + "."
+
+	names := make(map[string]string, len(fns))
+	for name, fn := range fns {
+		function := runtime.FuncForPC(reflect.ValueOf(fn).Pointer()).Name()
+		if strings.HasPrefix(function, prefix) && token.IsExported(function[len(prefix):]) {
+			names[name] = function[len(prefix):]
+		}
+	}
+
+	return names
+}
+
+type kind int
+
+const (
+	kindUnsupported kind = iota
+	kindString
+	kindInt
+	kindUint
+	kindFloat
+	kindBool
+	kindTime
+	kindStruct
+	kindPointer
+	kindSlice
+	kindMap
+)
+
+// fieldType is what the generator knows about the type of a field from the
+// syntax of the package.
+type fieldType struct {
+	kind kind
+	// name is the type as written in the source, such as "Plan" or
+	// "[]LineItem".
+	name string
+	// basic is the predeclared type underlying the string, number and bool
+	// kinds.
+	basic string
+	// stringer is set for named types with a String method, which the
+	// reflection-based validator formats with it unless they are strings.
+	stringer bool
+	elem     *fieldType
+	fields   []*field
+	// comparable is set for struct types whose values can be compared with
+	// ==.
+	comparable bool
+}
+
+type field struct {
+	name     string
+	jsonName string
+	tag      string
+	typ      *fieldType
+	pos      token.Pos
+}
+
+type packageInfo struct {
+	fset      *token.FileSet
+	name      string
+	specs     map[string]*ast.TypeSpec
+	order     []string
+	stringers map[string]bool
+	resolved  map[string]*fieldType
+}
+
+// generate parses the package in dir, ignoring test files and the files in
+// exclude, and returns the Validate methods of the named struct types, or of
+// every struct type with validate tags when types is empty, together with
+// their tests. rules maps custom validation names to functions of the package.
+func generate(dir string, types []string, rules map[string]string, exclude []string) ([]byte, []byte, error) {
+	pkg, err := parsePackage(dir, exclude)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(types) == 0 {
+		for _, name := range pkg.order {
+			if t := pkg.resolveNamed(name); t.kind == kindStruct && hasRules(t, map[*fieldType]bool{}) {
+				types = append(types, name)
+			}
+		}
+		if len(types) == 0 {
+			return nil, nil, fmt.Errorf("no struct types with validate tags in %s", dir)
+		}
+	}
+
+	g := &generator{pkg: pkg, rules: rules, imports: map[string]bool{}}
+	var roots []*fieldType
+	for _, name := range types {
+		if _, ok := pkg.specs[name]; !ok {
+			return nil, nil, fmt.Errorf("type %s not found in %s", name, dir)
+		}
+		t := pkg.resolveNamed(name)
+		if t.kind != kindStruct {
+			return nil, nil, fmt.Errorf("type %s is not a struct", name)
+		}
+		if err := g.generateType(t); err != nil {
+			return nil, nil, err
+		}
+		roots = append(roots, t)
+	}
+
+	code, err := g.file()
+	if err != nil {
+		return nil, nil, err
+	}
+	testCode, err := g.testFile(roots)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return code, testCode, nil
+}
+
+func parsePackage(dir string, exclude []string) (*packageInfo, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	pkg := &packageInfo{
+		fset:      token.NewFileSet(),
+		specs:     map[string]*ast.TypeSpec{},
+		stringers: map[string]bool{},
+		resolved:  map[string]*fieldType{},
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") || containsString(exclude, name) {
+			continue
+		}
+
+		file, err := parser.ParseFile(pkg.fset, filepath.Join(dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		if pkg.name == "" {
+			pkg.name = file.Name.Name
+		} else if pkg.name != file.Name.Name {
+			return nil, fmt.Errorf("found packages %s and %s in %s", pkg.name, file.Name.Name, dir)
+		}
+
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					if spec, ok := spec.(*ast.TypeSpec); ok {
+						pkg.specs[spec.Name.Name] = spec
+						pkg.order = append(pkg.order, spec.Name.Name)
+					}
+				}
+			case *ast.FuncDecl:
+				if decl.Recv != nil && decl.Name.Name == "String" && len(decl.Recv.List) == 1 {
+					receiver := decl.Recv.List[0].Type
+					if star, ok := receiver.(*ast.StarExpr); ok {
+						receiver = star.X
+					}
+					if ident, ok := receiver.(*ast.Ident); ok {
+						pkg.stringers[ident.Name] = true
+					}
+				}
+			}
+		}
+	}
+	if pkg.name == "" {
+		return nil, fmt.Errorf("no Go files in %s", dir)
+	}
+
+	return pkg, nil
+}
+
+var basicKinds = map[string]kind{
+	"string": kindString, "bool": kindBool,
+	"int": kindInt, "int8": kindInt, "int16": kindInt, "int32": kindInt, "int64": kindInt, "rune": kindInt,
+	"uint": kindUint, "uint8": kindUint, "uint16": kindUint, "uint32": kindUint, "uint64": kindUint, "uintptr": kindUint, "byte": kindUint,
+	"float32": kindFloat, "float64": kindFloat,
+}
+
+// resolveNamed resolves a type declared in the package.
+func (pkg *packageInfo) resolveNamed(name string) *fieldType {
+	if t, ok := pkg.resolved[name]; ok {
+		return t
+	}
+
+	spec := pkg.specs[name]
+	if structType, ok := spec.Type.(*ast.StructType); ok && spec.TypeParams == nil {
+		// Registered before its fields are resolved, so that recursive
+		// types terminate.
+		t := &fieldType{kind: kindStruct, name: name}
+		pkg.resolved[name] = t
+		t.fields = pkg.resolveFields(structType)
+		t.comparable = isComparable(t, map[*fieldType]bool{})
+		return t
+	}
+
+	pkg.resolved[name] = &fieldType{kind: kindUnsupported, name: name}
+	underlying := pkg.resolve(spec.Type)
+	t := *underlying
+	t.name = name
+	t.stringer = pkg.stringers[name]
+	if spec.Assign.IsValid() {
+		t.name = underlying.name
+	}
+	pkg.resolved[name] = &t
+
+	return &t
+}
+
+func (pkg *packageInfo) resolve(expr ast.Expr) *fieldType {
+	name := types.ExprString(expr)
+
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		if k, ok := basicKinds[expr.Name]; ok {
+			return &fieldType{kind: k, name: name, basic: expr.Name}
+		}
+		if _, ok := pkg.specs[expr.Name]; ok {
+			return pkg.resolveNamed(expr.Name)
+		}
+	case *ast.SelectorExpr:
+		switch name {
+		case "time.Time":
+			return &fieldType{kind: kindTime, name: name}
+		case "time.Duration":
+			return &fieldType{kind: kindInt, name: name, basic: "int64", stringer: true}
+		}
+	case *ast.StarExpr:
+		return &fieldType{kind: kindPointer, name: name, elem: pkg.resolve(expr.X)}
+	case *ast.ArrayType:
+		if expr.Len == nil {
+			return &fieldType{kind: kindSlice, name: name, elem: pkg.resolve(expr.Elt)}
+		}
+	case *ast.MapType:
+		return &fieldType{kind: kindMap, name: name}
+	case *ast.StructType:
+		t := &fieldType{kind: kindStruct, name: name, fields: pkg.resolveFields(expr)}
+		t.comparable = isComparable(t, map[*fieldType]bool{})
+		return t
+	case *ast.ParenExpr:
+		return pkg.resolve(expr.X)
+	}
+
+	return &fieldType{kind: kindUnsupported, name: name}
+}
+
+func (pkg *packageInfo) resolveFields(structType *ast.StructType) []*field {
+	var fields []*field
+	for _, astField := range structType.Fields.List {
+		tag := ""
+		if astField.Tag != nil {
+			tag, _ = strconv.Unquote(astField.Tag.Value)
+		}
+		structTag := reflect.StructTag(tag)
+
+		names := astField.Names
+		if len(names) == 0 {
+			// An embedded field is named after its type.
+			typeExpr := astField.Type
+			if star, ok := typeExpr.(*ast.StarExpr); ok {
+				typeExpr = star.X
+			}
+			if selector, ok := typeExpr.(*ast.SelectorExpr); ok {
+				typeExpr = selector.Sel
+			}
+			if ident, ok := typeExpr.(*ast.Ident); ok {
+				names = []*ast.Ident{ident}
+			}
+		}
+
+		typ := pkg.resolve(astField.Type)
+		for _, name := range names {
+			jsonName, _, _ := strings.Cut(structTag.Get("json"), ",")
+			if jsonName == "" || jsonName == "-" {
+				jsonName = name.Name
+			}
+			fields = append(fields, &field{
+				name:     name.Name,
+				jsonName: jsonName,
+				tag:      structTag.Get("validate"),
+				typ:      typ,
+				pos:      astField.Pos(),
+			})
+		}
+	}
+
+	return fields
+}
+
+func isComparable(t *fieldType, seen map[*fieldType]bool) bool {
+	switch t.kind {
+	case kindSlice, kindMap, kindUnsupported:
+		return false
+	case kindStruct:
+		if seen[t] {
+			return true
+		}
+		seen[t] = true
+		for _, f := range t.fields {
+			if !isComparable(f.typ, seen) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// hasRules reports whether validating a value of type t checks any rule.
+func hasRules(t *fieldType, seen map[*fieldType]bool) bool {
+	switch t.kind {
+	case kindPointer, kindSlice:
+		return hasRules(t.elem, seen)
+	case kindStruct:
+		if seen[t] {
+			return false
+		}
+		seen[t] = true
+		for _, f := range t.fields {
+			if !token.IsExported(f.name) || f.tag == "-" {
+				continue
+			}
+			if f.tag != "" || hasRules(f.typ, seen) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// path builds the Go expression of a field path such as
+// "items[" + strconv.Itoa(i0) + "].sku".
+type path []string
+
+func (p path) field(name string) path {
+	if len(p) == 0 {
+		return path{strconv.Quote(name)}
+	}
+
+	return p.append(strconv.Quote("." + name))
+}
+
+func (p path) index(variable string) path {
+	return p.append(strconv.Quote("["), "strconv.Itoa("+variable+")", strconv.Quote("]"))
+}
+
+func (p path) append(parts ...string) path {
+	result := append(path{}, p...)
+	for _, part := range parts {
+		last := len(result) - 1
+		if last >= 0 && isQuoted(result[last]) && isQuoted(part) {
+			prefix, _ := strconv.Unquote(result[last])
+			suffix, _ := strconv.Unquote(part)
+			result[last] = strconv.Quote(prefix + suffix)
+			continue
+		}
+		result = append(result, part)
+	}
+
+	return result
+}
+
+func (p path) String() string {
+	return strings.Join(p, " + ")
+}
+
+func isQuoted(part string) bool {
+	return strings.HasPrefix(part, `"`)
+}
+
+// scope is a struct in which field references are resolved, and the
+// expression of its value.
+type scope struct {
+	typ  *fieldType
+	expr string
+}
+
+type generator struct {
+	pkg     *packageInfo
+	rules   map[string]string
+	imports map[string]bool
+	buf     bytes.Buffer
+	loops   int
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+func (g *generator) errorf(pos token.Pos, format string, args ...interface{}) error {
+	return fmt.Errorf("%s: %s", g.pkg.fset.Position(pos), fmt.Sprintf(format, args...))
+}
+
+func (g *generator) generateType(t *fieldType) error {
+	g.printf("// Validate checks v against the validate tags of %s and returns\n", t.name)
+	g.printf("// validator.ValidationErrors listing every invalid field, or nil.\n")
+	g.printf("func (v *%s) Validate() error {\n", t.name)
+	g.printf("if v == nil {\nreturn nil\n}\n\n")
+	g.printf("var errs validator.ValidationErrors\n")
+
+	root := scope{typ: t, expr: "v"}
+	if err := g.walkStruct(t, "v", nil, root, nil); err != nil {
+		return err
+	}
+
+	g.printf("\nif len(errs) > 0 {\nreturn errs\n}\n\nreturn nil\n}\n\n")
+
+	return nil
+}
+
+func (g *generator) walkStruct(t *fieldType, expr string, p path, root scope, stack []*fieldType) error {
+	for _, ancestor := range stack {
+		if ancestor == t {
+			return fmt.Errorf("recursive type %s is not supported", t.name)
+		}
+	}
+	stack = append(stack, t)
+
+	parent := scope{typ: t, expr: expr}
+	for _, f := range t.fields {
+		if !token.IsExported(f.name) || f.tag == "-" {
+			continue
+		}
+
+		fieldExpr := selector(expr) + "." + f.name
+		fieldPath := p.field(f.jsonName)
+		if f.tag != "" {
+			if err := g.checkField(f, fieldExpr, fieldPath, parent, root); err != nil {
+				return err
+			}
+		}
+		if err := g.walkValue(f.typ, fieldExpr, fieldPath, root, stack); err != nil {
+			return g.errorf(f.pos, "%v", err)
+		}
+	}
+
+	return nil
+}
+
+// walkValue descends into the structs held by a value of type t.
+func (g *generator) walkValue(t *fieldType, expr string, p path, root scope, stack []*fieldType) error {
+	if !hasRules(t, map[*fieldType]bool{}) {
+		return nil
+	}
+
+	switch t.kind {
+	case kindStruct:
+		return g.walkStruct(t, expr, p, root, stack)
+	case kindPointer:
+		g.printf("if %s != nil {\n", expr)
+		elemExpr := "*" + expr
+		if t.elem.kind == kindStruct {
+			elemExpr = expr
+		}
+		if err := g.walkValue(t.elem, elemExpr, p, root, stack); err != nil {
+			return err
+		}
+		g.printf("}\n")
+	case kindSlice:
+		g.imports["strconv"] = true
+		index := "i" + strconv.Itoa(g.loops)
+		g.loops++
+		g.printf("for %s := range %s {\n", index, expr)
+		if err := g.walkValue(t.elem, selector(expr)+"["+index+"]", p.index(index), root, stack); err != nil {
+			return err
+		}
+		g.printf("}\n")
+	}
+
+	return nil
+}
+
+// clause is a case of the switch checking the rules of a field. A clause
+// without a message stops checking the field.
+type clause struct {
+	condition string
+	rule      string
+	param     string
+	message   string
+}
+
+func (g *generator) checkField(f *field, expr string, p path, parent scope, root scope) error {
+	empty, err := g.emptyExpr(expr, f.typ)
+	if err != nil {
+		return g.errorf(f.pos, "field %s: %v", f.name, err)
+	}
+	value, valueType, isNil := derefExpr(expr, f.typ)
+
+	var clauses []clause
+	checkedNil := false
+	for _, rule := range strings.Split(f.tag, ",") {
+		name, param, _ := strings.Cut(rule, "=")
+		fail := func(condition string, message string) {
+			clauses = append(clauses, clause{condition: condition, rule: name, param: param, message: message})
+		}
+		tagError := func(err error) error {
+			return g.errorf(f.pos, "invalid tag %q on %s: %v", rule, f.name, err)
+		}
+
+		switch name {
+		case "omitempty", "required":
+			// Both leave no nil pointer to the remaining rules.
+			if name == "omitempty" {
+				clauses = append(clauses, clause{condition: empty})
+			} else {
+				fail(empty, "is required")
+			}
+			checkedNil = true
+			continue
+		case "required_if", "required_unless":
+			matches, err := g.matchesExpr(parent, root, param)
+			if err != nil {
+				return tagError(err)
+			}
+			if name == "required_unless" {
+				matches = not(matches)
+			}
+			fail(and(empty, matches), "is required")
+			continue
+		case "required_with", "excluded_with":
+			names := strings.Fields(param)
+			if len(names) == 0 {
+				return tagError(errors.New("expected field names"))
+			}
+			for _, otherName := range names {
+				otherExpr, otherType, err := g.resolveField(parent, root, otherName)
+				if err != nil {
+					return tagError(err)
+				}
+				otherEmpty, err := g.emptyExpr(otherExpr, otherType)
+				if err != nil {
+					return tagError(err)
+				}
+				if name == "required_with" {
+					fail(and(empty, not(otherEmpty)), "is required when "+otherName+" is set")
+				} else {
+					fail(and(not(empty), not(otherEmpty)), "must be empty when "+otherName+" is set")
+				}
+			}
+			continue
+		}
+
+		if isNil != "" && !checkedNil {
+			// A nil pointer has no value to check against the remaining
+			// rules.
+			clauses = append(clauses, clause{condition: isNil})
+			checkedNil = true
+		}
+
+		switch name {
+		case "eqfield", "nefield", "gtfield", "gtefield", "ltfield", "ltefield":
+			otherExpr, otherType, err := g.resolveField(parent, root, param)
+			if err != nil {
+				return tagError(err)
+			}
+			other, otherValueType, otherNil := derefExpr(otherExpr, otherType)
+			condition, message, err := g.compareExpr(name, value, valueType, other, otherValueType, param)
+			if err != nil {
+				return tagError(err)
+			}
+			switch {
+			case otherNil == "":
+				fail(condition, message)
+			case name == "nefield":
+				fail(and(not(otherNil), condition), message)
+			default:
+				fail(otherNil, "requires "+param+" to be set")
+				fail(condition, message)
+			}
+		case "min", "max", "len":
+			condition, message, err := g.sizeExpr(name, value, valueType, param)
+			if err != nil {
+				return tagError(err)
+			}
+			fail(condition, message)
+		case "oneof":
+			values := strings.Fields(param)
+			if len(values) == 0 {
+				return tagError(errors.New("expected values"))
+			}
+			fail(not(g.valueMatchesExpr(value, valueType, values)), "must be one of "+param)
+		default:
+			function, ok := g.rules[name]
+			if !ok {
+				function, ok = validations[name]
+				function = "validator." + function
+			}
+			if !ok {
+				return tagError(errors.New("unknown rule"))
+			}
+			if valueType.kind != kindString {
+				return tagError(errors.New("rule applies to strings only"))
+			}
+			fail("!"+function+"("+stringExpr(value, valueType)+")", "must be a valid "+name)
+		}
+	}
+
+	g.printf("switch {\n")
+	for _, c := range clauses {
+		g.printf("case %s:\n", c.condition)
+		if c.message != "" {
+			g.printf("errs = append(errs, &validator.FieldError{Field: %s, Rule: %q, Param: %q, Message: %q})\n",
+				p, c.rule, c.param, c.message)
+		}
+	}
+	g.printf("}\n")
+
+	return nil
+}
+
+// derefExpr follows the pointers of a value of type t and returns the
+// expression of the value pointed to, its type, and a condition holding when
+// one of the pointers is nil, or "" when t is not a pointer.
+func derefExpr(expr string, t *fieldType) (string, *fieldType, string) {
+	var nilConditions []string
+	for t.kind == kindPointer {
+		nilConditions = append(nilConditions, expr+" == nil")
+		expr = "*" + expr
+		t = t.elem
+	}
+
+	return expr, t, strings.Join(nilConditions, " || ")
+}
+
+// emptyExpr returns a condition holding when the value is empty in the sense
+// of the required rule: a nil pointer or a pointer to an empty value, an empty
+// slice or map, or the zero value.
+func (g *generator) emptyExpr(expr string, t *fieldType) (string, error) {
+	switch t.kind {
+	case kindPointer:
+		elem, err := g.emptyExpr("*"+expr, t.elem)
+		if err != nil {
+			return "", err
+		}
+		return expr + " == nil || " + paren(elem), nil
+	case kindSlice, kindMap:
+		return "len(" + expr + ") == 0", nil
+	}
+
+	return g.zeroExpr(expr, t, map[*fieldType]bool{})
+}
+
+// zeroExpr returns a condition holding when the value is the zero value of
+// its type.
+func (g *generator) zeroExpr(expr string, t *fieldType, seen map[*fieldType]bool) (string, error) {
+	switch t.kind {
+	case kindPointer, kindSlice, kindMap:
+		return expr + " == nil", nil
+	case kindString:
+		return expr + ` == ""`, nil
+	case kindInt, kindUint, kindFloat:
+		return expr + " == 0", nil
+	case kindBool:
+		return "!" + expr, nil
+	case kindTime:
+		g.imports["time"] = true
+		return expr + " == (time.Time{})", nil
+	case kindStruct:
+		if t.comparable && token.IsIdentifier(t.name) {
+			return expr + " == (" + t.name + "{})", nil
+		}
+		if seen[t] {
+			break
+		}
+		seen[t] = true
+		defer delete(seen, t)
+
+		var conditions []string
+		for _, f := range t.fields {
+			if f.name == "_" {
+				continue
+			}
+			condition, err := g.zeroExpr(selector(expr)+"."+f.name, f.typ, seen)
+			if err != nil {
+				return "", err
+			}
+			conditions = append(conditions, paren(condition))
+		}
+		if len(conditions) == 0 {
+			return "true", nil
+		}
+		return strings.Join(conditions, " && "), nil
+	}
+
+	return "", fmt.Errorf("values of type %s are not supported", t.name)
+}
+
+// resolveField finds a dotted field path in the struct containing the tag or,
+// failing that, in the struct being validated.
+func (g *generator) resolveField(parent scope, root scope, name string) (string, *fieldType, error) {
+	expr, t, err := lookupField(parent, name)
+	if err == errFieldNotFound {
+		expr, t, err = lookupField(root, name)
+	}
+	if err == errFieldNotFound {
+		return "", nil, errors.New("unknown field " + name)
+	}
+
+	return expr, t, err
+}
+
+var errFieldNotFound = errors.New("field not found")
+
+func lookupField(s scope, name string) (string, *fieldType, error) {
+	expr, t := s.expr, s.typ
+	for i, segment := range strings.Split(name, ".") {
+		if t.kind == kindPointer {
+			return "", nil, errors.New("field path " + name + " crosses a pointer")
+		}
+		if t.kind != kindStruct {
+			return "", nil, errFieldNotFound
+		}
+
+		var found *field
+		for _, f := range t.fields {
+			if token.IsExported(f.name) && (f.name == segment || f.jsonName == segment) {
+				found = f
+				break
+			}
+		}
+		if found == nil {
+			if i > 0 {
+				return "", nil, errors.New("unknown field " + name)
+			}
+			return "", nil, errFieldNotFound
+		}
+		expr, t = expr+"."+found.name, found.typ
+	}
+
+	return expr, t, nil
+}
+
+// matchesExpr returns a condition holding when every field of a required_if
+// parameter such as "Country AT|BE Type business" has one of its values.
+func (g *generator) matchesExpr(parent scope, root scope, param string) (string, error) {
+	words := strings.Fields(param)
+	if len(words) == 0 || len(words)%2 != 0 {
+		return "", errors.New("expected field and value pairs")
+	}
+
+	var conditions []string
+	for i := 0; i < len(words); i += 2 {
+		expr, t, err := g.resolveField(parent, root, words[i])
+		if err != nil {
+			return "", err
+		}
+		values := strings.Split(words[i+1], "|")
+
+		value, valueType, isNil := derefExpr(expr, t)
+		condition := g.valueMatchesExpr(value, valueType, values)
+		if isNil != "" {
+			// A nil pointer is formatted as the empty string.
+			if containsString(values, "") {
+				condition = isNil + " || " + paren(condition)
+			} else {
+				condition = and(not(isNil), condition)
+			}
+		}
+		conditions = append(conditions, paren(condition))
+	}
+
+	return strings.Join(conditions, " && "), nil
+}
+
+// valueMatchesExpr returns a condition holding when the value, formatted as
+// by fmt.Sprint, is one of values.
+func (g *generator) valueMatchesExpr(expr string, t *fieldType, values []string) string {
+	var conditions []string
+	for _, value := range values {
+		switch {
+		case t.kind == kindString:
+			conditions = append(conditions, stringExpr(expr, t)+" == "+strconv.Quote(value))
+		case t.kind == kindInt && !t.stringer:
+			if n, err := strconv.ParseInt(value, 10, 64); err == nil && strconv.FormatInt(n, 10) == value {
+				conditions = append(conditions, expr+" == "+value)
+			}
+		case t.kind == kindUint && !t.stringer:
+			if n, err := strconv.ParseUint(value, 10, 64); err == nil && strconv.FormatUint(n, 10) == value {
+				conditions = append(conditions, expr+" == "+value)
+			}
+		case t.kind == kindBool && !t.stringer:
+			if value == "true" {
+				conditions = append(conditions, expr)
+			} else if value == "false" {
+				conditions = append(conditions, "!"+expr)
+			}
+		default:
+			g.imports["fmt"] = true
+			conditions = append(conditions, "fmt.Sprint("+expr+") == "+strconv.Quote(value))
+		}
+	}
+	if len(conditions) == 0 {
+		return "false"
+	}
+
+	return strings.Join(conditions, " || ")
+}
+
+func isNumber(t *fieldType) bool {
+	return t.kind == kindInt || t.kind == kindUint || t.kind == kindFloat
+}
+
+// operand is a number compared by a generated condition: the value of a field
+// or of a size, or a literal limit.
+type operand struct {
+	expr string
+	kind kind
+	// name and basic are the type of the value and its underlying predeclared
+	// type; they are empty for literals.
+	name    string
+	basic   string
+	literal bool
+}
+
+// as returns the operand converted to a predeclared type.
+func (o operand) as(basic string) string {
+	if o.literal || o.name == basic {
+		return o.expr
+	}
+
+	return basic + "(" + o.expr + ")"
+}
+
+// fits reports whether the literal o converts exactly to the predeclared type
+// basic, assuming that int, uint and uintptr may be 32 bits wide.
+func (o operand) fits(basic string) bool {
+	bits := map[string]int{
+		"int": 32, "int8": 8, "int16": 16, "int32": 32, "rune": 32, "int64": 64,
+		"uint": 32, "uint8": 8, "byte": 8, "uint16": 16, "uint32": 32, "uint64": 64, "uintptr": 32,
+	}[basic]
+	switch {
+	case o.kind == kindFloat:
+		return basic == "float64"
+	case basic == "float64":
+		return true
+	case bits == 0:
+		return false
+	case strings.HasPrefix(basic, "u") || basic == "byte":
+		_, err := strconv.ParseUint(o.expr, 10, bits)
+		return err == nil
+	}
+	_, err := strconv.ParseInt(o.expr, 10, bits)
+	return err == nil
+}
+
+var flippedOps = map[string]string{"==": "==", "!=": "!=", "<": ">", ">": "<", "<=": ">=", ">=": "<="}
+
+var negatedOps = map[string]string{"==": "!=", "!=": "==", "<": ">=", ">": "<=", "<=": ">", ">=": "<"}
+
+// numberRelation returns the condition a op b, where only b may be a literal.
+// As in the reflection-based validator, integers are compared exactly, with
+// signed values below every unsigned value when negative, and as float64 only
+// against a float.
+func numberRelation(a operand, op string, b operand) string {
+	if b.literal && b.kind == kindInt && a.kind == kindUint {
+		// A negative literal is below every unsigned value, which float64
+		// preserves.
+		if strings.HasPrefix(b.expr, "-") {
+			b.kind = kindFloat
+		} else {
+			b.kind = kindUint
+		}
+	}
+
+	switch {
+	case b.literal && b.fits(a.basic):
+		return a.expr + " " + op + " " + b.expr
+	case a.kind == kindFloat || b.kind == kindFloat:
+		return a.as("float64") + " " + op + " " + b.as("float64")
+	case a.kind == b.kind:
+		basic := "int64"
+		if a.kind == kindUint {
+			basic = "uint64"
+		}
+		if a.name == b.name {
+			return a.expr + " " + op + " " + b.expr
+		}
+		return a.as(basic) + " " + op + " " + b.as(basic)
+	case a.kind == kindUint && !b.literal:
+		return numberRelation(b, flippedOps[op], a)
+	}
+
+	relation := a.as("uint64") + " " + op + " " + b.as("uint64")
+	if op == "==" || op == ">" || op == ">=" {
+		return a.expr + " >= 0 && " + relation
+	}
+	return a.expr + " < 0 || " + relation
+}
+
+// compareExpr returns a condition holding when a cross-field rule fails, and
+// the message reporting it.
+func (g *generator) compareExpr(rule string, a string, at *fieldType, b string, bt *fieldType, other string) (string, string, error) {
+	sameType := at.name == bt.name
+	float := false
+
+	var relation func(op string) string
+	switch {
+	case at.kind == kindTime && bt.kind == kindTime:
+		methods := map[string]string{"==": "Equal", "!=": "!Equal", "<": "Before", ">": "After", "<=": "!After", ">=": "!Before"}
+		relation = func(op string) string {
+			method := methods[op]
+			return method[:strings.Count(method, "!")] + selector(a) + "." + strings.TrimPrefix(method, "!") + "(" + b + ")"
+		}
+	case at.kind == kindString && bt.kind == kindString:
+		if !sameType {
+			a, b = "string("+a+")", "string("+b+")"
+		}
+		relation = func(op string) string { return a + " " + op + " " + b }
+	case isNumber(at) && isNumber(bt):
+		float = at.kind == kindFloat || bt.kind == kindFloat
+		x := operand{expr: a, kind: at.kind, name: at.name, basic: at.basic}
+		y := operand{expr: b, kind: bt.kind, name: bt.name, basic: bt.basic}
+		relation = func(op string) string { return numberRelation(x, op, y) }
+	case sameType && (rule == "eqfield" || rule == "nefield") && (at.kind == kindBool || at.kind == kindStruct && at.comparable):
+		relation = func(op string) string { return a + " " + op + " " + b }
+	default:
+		return "", "", fmt.Errorf("cannot compare %s with %s", at.name, bt.name)
+	}
+
+	// The condition is the negated relation, except that an ordering of
+	// floats fails for NaN.
+	failure := func(op string) string {
+		if float && op != "==" && op != "!=" {
+			return "!(" + relation(op) + ")"
+		}
+		return relation(negatedOps[op])
+	}
+
+	switch rule {
+	case "eqfield":
+		return failure("=="), "must equal " + other, nil
+	case "nefield":
+		return failure("!="), "must differ from " + other, nil
+	case "gtfield":
+		return failure(">"), "must be after " + other, nil
+	case "gtefield":
+		return failure(">="), "must not be before " + other, nil
+	case "ltfield":
+		return failure("<"), "must be before " + other, nil
+	}
+
+	return failure("<="), "must not be after " + other, nil
+}
+
+// sizeExpr returns a condition holding when a min, max or len rule fails, and
+// the message reporting it.
+func (g *generator) sizeExpr(rule string, expr string, t *fieldType, param string) (string, string, error) {
+	limit := operand{literal: true}
+	if n, err := strconv.ParseInt(param, 10, 64); err == nil {
+		limit.expr, limit.kind = strconv.FormatInt(n, 10), kindInt
+	} else if n, err := strconv.ParseUint(param, 10, 64); err == nil {
+		limit.expr, limit.kind = strconv.FormatUint(n, 10), kindUint
+	} else if n, err := strconv.ParseFloat(param, 64); err == nil && !math.IsNaN(n) {
+		limit.expr, limit.kind = strconv.FormatFloat(n, 'g', -1, 64), kindFloat
+		if math.IsInf(n, 0) {
+			g.imports["math"] = true
+			limit.expr = "math.Inf(" + strconv.Itoa(int(math.Copysign(1, n))) + ")"
+		}
+	} else {
+		return "", "", errors.New("expected a number")
+	}
+
+	var size operand
+	var prefix, unit string
+	switch {
+	case t.kind == kindString:
+		g.imports["unicode/utf8"] = true
+		size = operand{expr: "utf8.RuneCountInString(" + stringExpr(expr, t) + ")", kind: kindInt, name: "int", basic: "int"}
+		prefix, unit = "must be ", " characters long"
+	case t.kind == kindSlice || t.kind == kindMap:
+		size = operand{expr: "len(" + expr + ")", kind: kindInt, name: "int", basic: "int"}
+		prefix, unit = "must have ", " elements"
+	case isNumber(t) && rule != "len":
+		size = operand{expr: expr, kind: t.kind, name: t.name, basic: t.basic}
+		prefix = "must be "
+	default:
+		return "", "", fmt.Errorf("rule does not apply to %s", t.name)
+	}
+
+	// Only a float field can be NaN, which fails min and max.
+	failure := func(op string) string {
+		if size.kind == kindFloat {
+			return "!(" + numberRelation(size, op, limit) + ")"
+		}
+		return numberRelation(size, negatedOps[op], limit)
+	}
+
+	switch rule {
+	case "min":
+		return failure(">="), prefix + "at least " + param + unit, nil
+	case "max":
+		return failure("<="), prefix + "at most " + param + unit, nil
+	}
+
+	return failure("=="), prefix + "exactly " + param + unit, nil
+}
+
+// selector returns expr in a form that a selector or index can be applied to,
+// relying on the implicit dereference of a pointer to a struct.
+func selector(expr string) string {
+	if strings.HasPrefix(expr, "**") {
+		return "(" + expr + ")"
+	}
+
+	return strings.TrimPrefix(expr, "*")
+}
+
+// stringExpr converts a value of a string kind to string.
+func stringExpr(expr string, t *fieldType) string {
+	if t.name == "string" {
+		return expr
+	}
+
+	return "string(" + expr + ")"
+}
+
+func paren(condition string) string {
+	if strings.Contains(condition, "||") || strings.Contains(condition, "&&") {
+		return "(" + condition + ")"
+	}
+
+	return condition
+}
+
+func and(a string, b string) string {
+	return paren(a) + " && " + paren(b)
+}
+
+func not(condition string) string {
+	// Quoted values have no spaces, so " == " is the operator.
+	if strings.Count(condition, " == ") == 1 && !strings.ContainsAny(condition, "|&") && condition[0] != '!' {
+		return strings.Replace(condition, " == ", " != ", 1)
+	}
+	if token.IsIdentifier(condition) || strings.HasSuffix(condition, ")") && !strings.ContainsAny(condition, " ") {
+		return "!" + condition
+	}
+
+	return "!(" + condition + ")"
+}
+
+func containsString(list []string, str string) bool {
+	for _, candidate := range list {
+		if candidate == str {
+			return true
+		}
+	}
+
+	return false
+}
+
+const header = "// Code generated by validator-gen; DO NOT EDIT.\n\n"
+
+func (g *generator) file() ([]byte, error) {
+	var file bytes.Buffer
+	file.WriteString(header)
+	fmt.Fprintf(&file, "package %s\n\n", g.pkg.name)
+
+	var imports []string
+	for path := range g.imports {
+		imports = append(imports, path)
+	}
+	sort.Strings(imports)
+	file.WriteString("import (\n")
+	for _, path := range imports {
+		fmt.Fprintf(&file, "%q\n", path)
+	}
+	file.WriteString("\nvalidator \"github.com/MrWormHole/simple-validator\"\n)\n\n")
+	file.Write(g.buf.Bytes())
+
+	return format.Source(file.Bytes())
+}
+
+func (g *generator) testFile(roots []*fieldType) ([]byte, error) {
+	var file bytes.Buffer
+	file.WriteString(header)
+	fmt.Fprintf(&file, "package %s\n\n", g.pkg.name)
+	file.WriteString("import (\n\"fmt\"\n\"testing\"\n\nvalidator \"github.com/MrWormHole/simple-validator\"\n)\n\n")
+
+	var custom []string
+	for name := range g.rules {
+		custom = append(custom, name)
+	}
+	sort.Strings(custom)
+	if len(custom) > 0 {
+		file.WriteString("func init() {\n")
+		for _, name := range custom {
+			fmt.Fprintf(&file, "validator.RegisterValidation(%q, %s)\n", name, g.rules[name])
+		}
+		file.WriteString("}\n\n")
+	}
+
+	for _, t := range roots {
+		fmt.Fprintf(&file, "func Test%sValidate(t *testing.T) {\n", t.name)
+		fmt.Fprintf(&file, "v := &%s{}\n", t.name)
+		file.WriteString("if expected, actual := fmt.Sprint(validator.ValidateStruct(v)), fmt.Sprint(v.Validate()); actual != expected {\n")
+		file.WriteString("t.Errorf(\"Validate() = %s, ValidateStruct gives %s\", actual, expected)\n}\n}\n\n")
+
+		var params, zeros, assignments []string
+		for _, f := range t.fields {
+			if !token.IsExported(f.name) || !isFuzzable(f.typ) {
+				continue
+			}
+			param := fuzzParamName(f.name)
+			params = append(params, param+" "+f.typ.basic)
+			zeros = append(zeros, zeroLiteral(f.typ))
+			if f.typ.name == f.typ.basic {
+				assignments = append(assignments, f.name+": "+param)
+			} else {
+				assignments = append(assignments, f.name+": "+f.typ.name+"("+param+")")
+			}
+		}
+		if len(params) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(&file, "func Fuzz%sValidate(f *testing.F) {\n", t.name)
+		fmt.Fprintf(&file, "f.Add(%s)\n", strings.Join(zeros, ", "))
+		fmt.Fprintf(&file, "f.Fuzz(func(t *testing.T, %s) {\n", strings.Join(params, ", "))
+		fmt.Fprintf(&file, "v := &%s{%s}\n", t.name, strings.Join(assignments, ", "))
+		file.WriteString("if expected, actual := fmt.Sprint(validator.ValidateStruct(v)), fmt.Sprint(v.Validate()); actual != expected {\n")
+		file.WriteString("t.Errorf(\"Validate() = %s, ValidateStruct gives %s\", actual, expected)\n}\n})\n}\n\n")
+	}
+
+	return format.Source(file.Bytes())
+}
+
+// isFuzzable reports whether fields of type t can be set from the arguments
+// of a fuzz target.
+func isFuzzable(t *fieldType) bool {
+	switch t.kind {
+	case kindString, kindInt, kindUint, kindFloat, kindBool:
+		return t.basic != "uintptr" && !strings.Contains(t.name, ".")
+	}
+
+	return false
+}
+
+func fuzzParamName(name string) string {
+	// Lower the leading initialism as well, so that VATID becomes vatid and
+	// URLPath becomes urlPath.
+	upper := 0
+	for upper < len(name) && name[upper] >= 'A' && name[upper] <= 'Z' {
+		upper++
+	}
+	if upper > 1 && upper < len(name) {
+		upper--
+	}
+	param := strings.ToLower(name[:upper]) + name[upper:]
+	if token.IsKeyword(param) || containsString([]string{"t", "f", "v", "fmt", "testing", "validator"}, param) {
+		param += "Value"
+	}
+
+	return param
+}
+
+func zeroLiteral(t *fieldType) string {
+	switch t.kind {
+	case kindString:
+		return `""`
+	case kindBool:
+		return "false"
+	}
+
+	switch t.basic {
+	case "int":
+		return "0"
+	case "float64":
+		return "0.0"
+	}
+
+	return t.basic + "(0)"
+}
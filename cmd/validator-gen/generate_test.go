@@ -0,0 +1,123 @@
+package main
+
+import (
+	validator "github.com/MrWormHole/simple-validator"
+	"github.com/stretchr/testify/assert"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGenerateIsUpToDate(t *testing.T) {
+	assert := assert.New(t)
+
+	dir := filepath.Join("internal", "example")
+	code, testCode, err := generate(dir, []string{"Signup", "Booking", "Limits"}, map[string]string{"lowercase": "isLowercase"},
+		[]string{"validator_gen.go", "validator_gen_test.go"})
+	assert.NoError(err)
+
+	expected, err := os.ReadFile(filepath.Join(dir, "validator_gen.go"))
+	assert.NoError(err)
+	assert.Equal(string(expected), string(code), "run go generate in internal/example")
+
+	expected, err = os.ReadFile(filepath.Join(dir, "validator_gen_test.go"))
+	assert.NoError(err)
+	assert.Equal(string(expected), string(testCode), "run go generate in internal/example")
+}
+
+func TestGenerateAllTaggedTypes(t *testing.T) {
+	assert := assert.New(t)
+
+	dir := writePackage(t, `package p
+
+type Tagged struct {
+	Name string `+"`validate:\"required\"`"+`
+}
+
+type Nested struct {
+	Inner Tagged
+}
+
+type Untagged struct {
+	Name string
+}
+`)
+	code, _, err := generate(dir, nil, nil, nil)
+	assert.NoError(err)
+	assert.Contains(string(code), "func (v *Tagged) Validate() error")
+	assert.Contains(string(code), "func (v *Nested) Validate() error")
+	assert.NotContains(string(code), "Untagged")
+}
+
+func TestGenerateErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []struct {
+		field    string
+		expected string
+	}{
+		{"Name string `validate:\"required,bogus\"`", `p.go:4:2: invalid tag "bogus" on Name: unknown rule`},
+		{"Name string `validate:\"eqfield=Missing\"`", `p.go:4:2: invalid tag "eqfield=Missing" on Name: unknown field Missing`},
+		{"Count int `validate:\"email\"`", `p.go:4:2: invalid tag "email" on Count: rule applies to strings only`},
+		{"Count int `validate:\"len=3\"`", `p.go:4:2: invalid tag "len=3" on Count: rule does not apply to int`},
+		{"Name string `validate:\"min=x\"`", `p.go:4:2: invalid tag "min=x" on Name: expected a number`},
+		{"Name string `validate:\"gtfield=Tags\"`\n\tTags []string", `p.go:4:2: invalid tag "gtfield=Tags" on Name: cannot compare string with []string`},
+		{"Name string `validate:\"required_if=Name\"`", `p.go:4:2: invalid tag "required_if=Name" on Name: expected field and value pairs`},
+		{"Ch chan int `validate:\"required\"`", `p.go:4:2: field Ch: values of type chan int are not supported`},
+		{"Next *T\n\tName string `validate:\"required\"`", `p.go:4:2: recursive type T is not supported`},
+		{"Other *struct{ Name string }\n\tName string `validate:\"required_with=Other.Name\"`", `p.go:5:2: invalid tag "required_with=Other.Name" on Name: field path Other.Name crosses a pointer`},
+	}
+
+	for _, testCase := range testCases {
+		dir := writePackage(t, "package p\n\ntype T struct {\n\t"+testCase.field+"\n}\n")
+		_, _, err := generate(dir, []string{"T"}, nil, nil)
+		if assert.Error(err, testCase.field) {
+			assert.Equal(filepath.Join(dir, testCase.expected), err.Error())
+		}
+	}
+
+	_, _, err := generate(writePackage(t, "package p\n\ntype T int\n"), []string{"T"}, nil, nil)
+	assert.EqualError(err, "type T is not a struct")
+}
+
+func TestValidationsMatchValidateStruct(t *testing.T) {
+	assert := assert.New(t)
+
+	packages, err := parser.ParseDir(token.NewFileSet(), "../..", func(info fs.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	assert.NoError(err)
+
+	functions := map[string]bool{}
+	for _, pkg := range packages {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil && fn.Name.IsExported() {
+					functions[fn.Name.Name] = true
+				}
+			}
+		}
+	}
+
+	runtimeValidations := validator.Validations()
+	assert.Len(validations, len(runtimeValidations))
+	for name := range runtimeValidations {
+		assert.True(functions[validations[name]], name)
+	}
+
+	assert.Empty(packageFunctions(map[string]func(string) bool{"custom": func(string) bool { return true }}))
+}
+
+func writePackage(t *testing.T, source string) string {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "p.go"), []byte(source), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	return dir
+}
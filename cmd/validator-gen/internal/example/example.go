@@ -0,0 +1,78 @@
+// Package example holds the structs that validator-gen is tested with. Its
+// generated Validate methods are compared with validator.ValidateStruct by the
+// generated tests.
+package example
+
+import (
+	"strings"
+	"time"
+)
+
+//go:generate go run github.com/MrWormHole/simple-validator/cmd/validator-gen -type Signup,Booking,Limits -rule lowercase=isLowercase
+
+// Plan is a subscription plan.
+type Plan string
+
+type Address struct {
+	Street  string `json:"street"`
+	City    string `json:"city" validate:"required_with=Street"`
+	Country string `json:"country" validate:"required,iso3166_alpha2"`
+}
+
+type LineItem struct {
+	SKU      string `json:"sku" validate:"required,alphanum"`
+	Quantity int    `json:"quantity" validate:"min=1,max=100"`
+}
+
+type Signup struct {
+	Email           string     `json:"email" validate:"required,email"`
+	Username        string     `json:"username" validate:"required,min=3,max=20,lowercase"`
+	Password        string     `json:"password" validate:"required,min=8"`
+	ConfirmPassword string     `json:"confirm_password" validate:"eqfield=Password"`
+	Plan            Plan       `json:"plan" validate:"oneof=free pro"`
+	Seats           int        `json:"seats" validate:"required_if=Plan pro,omitempty,min=2"`
+	VATID           string     `json:"vat_id" validate:"required_if=Address.Country AT|BE|DE|FR,excluded_with=TaxExempt"`
+	TaxExempt       *bool      `json:"tax_exempt"`
+	Referrer        *string    `json:"referrer" validate:"omitempty,nefield=Email,uuid"`
+	Address         Address    `json:"address"`
+	Billing         *Address   `json:"billing"`
+	Items           []LineItem `json:"items" validate:"max=2"`
+	Tags            []string   `json:"tags" validate:"omitempty,min=1"`
+	Newsletter      bool       `json:"newsletter" validate:"required_unless=Plan free"`
+	Discount        float64    `json:"discount" validate:"min=0,max=0.5"`
+}
+
+type Booking struct {
+	From     time.Time  `validate:"required"`
+	To       *time.Time `validate:"omitempty,gtefield=From"`
+	Guests   uint8      `validate:"required,ltefield=Capacity"`
+	Capacity int        `validate:"oneof=2 4 8"`
+	Rooms    []*Room    `validate:"required,max=3"`
+	Tier     Tier       `validate:"omitempty,oneof=standard suite"`
+}
+
+// Tier is a room category, displayed in upper case but validated as is.
+type Tier string
+
+func (t Tier) String() string {
+	return strings.ToUpper(string(t))
+}
+
+type Room struct {
+	Name string `validate:"required"`
+	Beds int    `validate:"min=1"`
+}
+
+// Limits holds numbers compared at the bounds of their types.
+type Limits struct {
+	Low    int64   `validate:"min=-9007199254740993,ltfield=High"`
+	High   uint64  `validate:"max=18446744073709551614,nefield=Ratio"`
+	Ratio  float32 `validate:"min=0,max=1"`
+	Weight float64 `validate:"gtefield=Ratio"`
+	Step   int8    `validate:"max=300"`
+	Size   uint16  `validate:"min=-1"`
+}
+
+func isLowercase(str string) bool {
+	return str == strings.ToLower(str)
+}
@@ -0,0 +1,115 @@
+package example
+
+import (
+	"fmt"
+	validator "github.com/MrWormHole/simple-validator"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestGeneratedValidateMatchesValidateStruct(t *testing.T) {
+	assert := assert.New(t)
+
+	yes := true
+	referrer := "a987fbc9-4bed-3078-cf07-9141ba07c9f3"
+	email := "john.doe@example.com"
+	from := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	before := from.Add(-time.Hour)
+
+	valid := Signup{
+		Email:           email,
+		Username:        "johndoe",
+		Password:        "correct horse",
+		ConfirmPassword: "correct horse",
+		Plan:            "pro",
+		Seats:           5,
+		VATID:           "DE123456789",
+		Referrer:        &referrer,
+		Address:         Address{Street: "Unter den Linden 1", City: "Berlin", Country: "DE"},
+		Items:           []LineItem{{SKU: "A1", Quantity: 1}},
+		Newsletter:      true,
+	}
+
+	invalid := valid
+	invalid.Username = "JohnDoe"
+	invalid.ConfirmPassword = "correct horse battery"
+	invalid.Seats = 1
+	invalid.TaxExempt = &yes
+	invalid.Referrer = &email
+	invalid.Billing = &Address{Street: "Main St", Country: "Germany"}
+	invalid.Items = []LineItem{{SKU: "A-1", Quantity: 0}, {}, {}}
+	invalid.Tags = []string{}
+	invalid.Plan = "enterprise"
+	invalid.Newsletter = false
+	invalid.Discount = 0.75
+
+	values := []interface{ Validate() error }{
+		&valid,
+		&invalid,
+		&Booking{From: from, To: &from, Guests: 2, Capacity: 2, Rooms: []*Room{{Name: "101", Beds: 2}, nil}, Tier: "suite"},
+		&Booking{From: from, To: &before, Guests: 3, Capacity: 2, Rooms: []*Room{{}, {}, {}, {}}, Tier: "SUITE"},
+	}
+
+	assert.NoError(values[0].Validate())
+	assert.NoError(values[2].Validate())
+	assert.Contains(values[3].Validate().Error(), "Tier must be one of standard suite")
+	for _, v := range values {
+		assert.Equal(fmt.Sprint(validator.ValidateStruct(v)), fmt.Sprint(v.Validate()))
+	}
+
+	assert.Equal("username must be a valid lowercase; confirm_password must equal Password; "+
+		"plan must be one of free pro; seats must be at least 2; vat_id must be empty when TaxExempt is set; "+
+		"referrer must differ from Email; billing.city is required when Street is set; "+
+		"billing.country must be a valid iso3166_alpha2; items must have at most 2 elements; "+
+		"items[0].sku must be a valid alphanum; items[0].quantity must be at least 1; "+
+		"items[1].sku is required; items[1].quantity must be at least 1; "+
+		"items[2].sku is required; items[2].quantity must be at least 1; "+
+		"newsletter is required; discount must be at most 0.5", invalid.Validate().Error())
+}
+
+func TestGeneratedValidateComparesNumbersExactly(t *testing.T) {
+	assert := assert.New(t)
+
+	values := []*Limits{
+		{Low: 1<<53 + 1, High: 1<<53 + 2, Ratio: 0.5, Weight: 0.5},
+		{Low: 1<<53 + 1, High: 1<<53 + 1, Ratio: 0.5, Weight: 0.5},
+		{Low: -1 << 53, High: 1 << 53, Ratio: 1 << 53},
+		{Low: -9007199254740994, High: math.MaxUint64, Ratio: 1.5, Weight: -1},
+		{Low: math.MaxInt64, High: 1 << 63, Ratio: float32(math.NaN()), Weight: math.NaN()},
+		{Low: -1, High: 0, Ratio: float32(math.Inf(1)), Weight: math.Inf(1), Step: math.MaxInt8, Size: math.MaxUint16},
+	}
+
+	assert.NoError(values[0].Validate())
+	for _, v := range values {
+		assert.Equal(fmt.Sprint(validator.ValidateStruct(v)), fmt.Sprint(v.Validate()), v)
+	}
+
+	assert.EqualError(values[1].Validate(), "Low must be before High")
+	assert.EqualError(values[4].Validate(), "Ratio must be at least 0; Weight must not be before Ratio")
+}
+
+func BenchmarkValidate(b *testing.B) {
+	v := &Signup{
+		Email:           "john.doe@example.com",
+		Username:        "johndoe",
+		Password:        "correct horse",
+		ConfirmPassword: "correct horse",
+		Plan:            "free",
+		Address:         Address{Country: "US"},
+	}
+
+	b.Run("generated", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			v.Validate()
+		}
+	})
+	b.Run("reflection", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			validator.ValidateStruct(v)
+		}
+	})
+}
@@ -0,0 +1,236 @@
+// Code generated by validator-gen; DO NOT EDIT.
+
+package example
+
+import (
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	validator "github.com/MrWormHole/simple-validator"
+)
+
+// Validate checks v against the validate tags of Signup and returns
+// validator.ValidationErrors listing every invalid field, or nil.
+func (v *Signup) Validate() error {
+	if v == nil {
+		return nil
+	}
+
+	var errs validator.ValidationErrors
+	switch {
+	case v.Email == "":
+		errs = append(errs, &validator.FieldError{Field: "email", Rule: "required", Param: "", Message: "is required"})
+	case !validator.IsEmail(v.Email):
+		errs = append(errs, &validator.FieldError{Field: "email", Rule: "email", Param: "", Message: "must be a valid email"})
+	}
+	switch {
+	case v.Username == "":
+		errs = append(errs, &validator.FieldError{Field: "username", Rule: "required", Param: "", Message: "is required"})
+	case utf8.RuneCountInString(v.Username) < 3:
+		errs = append(errs, &validator.FieldError{Field: "username", Rule: "min", Param: "3", Message: "must be at least 3 characters long"})
+	case utf8.RuneCountInString(v.Username) > 20:
+		errs = append(errs, &validator.FieldError{Field: "username", Rule: "max", Param: "20", Message: "must be at most 20 characters long"})
+	case !isLowercase(v.Username):
+		errs = append(errs, &validator.FieldError{Field: "username", Rule: "lowercase", Param: "", Message: "must be a valid lowercase"})
+	}
+	switch {
+	case v.Password == "":
+		errs = append(errs, &validator.FieldError{Field: "password", Rule: "required", Param: "", Message: "is required"})
+	case utf8.RuneCountInString(v.Password) < 8:
+		errs = append(errs, &validator.FieldError{Field: "password", Rule: "min", Param: "8", Message: "must be at least 8 characters long"})
+	}
+	switch {
+	case v.ConfirmPassword != v.Password:
+		errs = append(errs, &validator.FieldError{Field: "confirm_password", Rule: "eqfield", Param: "Password", Message: "must equal Password"})
+	}
+	switch {
+	case !(string(v.Plan) == "free" || string(v.Plan) == "pro"):
+		errs = append(errs, &validator.FieldError{Field: "plan", Rule: "oneof", Param: "free pro", Message: "must be one of free pro"})
+	}
+	switch {
+	case v.Seats == 0 && string(v.Plan) == "pro":
+		errs = append(errs, &validator.FieldError{Field: "seats", Rule: "required_if", Param: "Plan pro", Message: "is required"})
+	case v.Seats == 0:
+	case v.Seats < 2:
+		errs = append(errs, &validator.FieldError{Field: "seats", Rule: "min", Param: "2", Message: "must be at least 2"})
+	}
+	switch {
+	case v.VATID == "" && (v.Address.Country == "AT" || v.Address.Country == "BE" || v.Address.Country == "DE" || v.Address.Country == "FR"):
+		errs = append(errs, &validator.FieldError{Field: "vat_id", Rule: "required_if", Param: "Address.Country AT|BE|DE|FR", Message: "is required"})
+	case v.VATID != "" && (!(v.TaxExempt == nil || !*v.TaxExempt)):
+		errs = append(errs, &validator.FieldError{Field: "vat_id", Rule: "excluded_with", Param: "TaxExempt", Message: "must be empty when TaxExempt is set"})
+	}
+	switch {
+	case v.Referrer == nil || *v.Referrer == "":
+	case *v.Referrer == v.Email:
+		errs = append(errs, &validator.FieldError{Field: "referrer", Rule: "nefield", Param: "Email", Message: "must differ from Email"})
+	case !validator.IsUUID(*v.Referrer):
+		errs = append(errs, &validator.FieldError{Field: "referrer", Rule: "uuid", Param: "", Message: "must be a valid uuid"})
+	}
+	switch {
+	case v.Address.City == "" && v.Address.Street != "":
+		errs = append(errs, &validator.FieldError{Field: "address.city", Rule: "required_with", Param: "Street", Message: "is required when Street is set"})
+	}
+	switch {
+	case v.Address.Country == "":
+		errs = append(errs, &validator.FieldError{Field: "address.country", Rule: "required", Param: "", Message: "is required"})
+	case !validator.IsISO3166Alpha2(v.Address.Country):
+		errs = append(errs, &validator.FieldError{Field: "address.country", Rule: "iso3166_alpha2", Param: "", Message: "must be a valid iso3166_alpha2"})
+	}
+	if v.Billing != nil {
+		switch {
+		case v.Billing.City == "" && v.Billing.Street != "":
+			errs = append(errs, &validator.FieldError{Field: "billing.city", Rule: "required_with", Param: "Street", Message: "is required when Street is set"})
+		}
+		switch {
+		case v.Billing.Country == "":
+			errs = append(errs, &validator.FieldError{Field: "billing.country", Rule: "required", Param: "", Message: "is required"})
+		case !validator.IsISO3166Alpha2(v.Billing.Country):
+			errs = append(errs, &validator.FieldError{Field: "billing.country", Rule: "iso3166_alpha2", Param: "", Message: "must be a valid iso3166_alpha2"})
+		}
+	}
+	switch {
+	case len(v.Items) > 2:
+		errs = append(errs, &validator.FieldError{Field: "items", Rule: "max", Param: "2", Message: "must have at most 2 elements"})
+	}
+	for i0 := range v.Items {
+		switch {
+		case v.Items[i0].SKU == "":
+			errs = append(errs, &validator.FieldError{Field: "items[" + strconv.Itoa(i0) + "].sku", Rule: "required", Param: "", Message: "is required"})
+		case !validator.IsAlphaNumeric(v.Items[i0].SKU):
+			errs = append(errs, &validator.FieldError{Field: "items[" + strconv.Itoa(i0) + "].sku", Rule: "alphanum", Param: "", Message: "must be a valid alphanum"})
+		}
+		switch {
+		case v.Items[i0].Quantity < 1:
+			errs = append(errs, &validator.FieldError{Field: "items[" + strconv.Itoa(i0) + "].quantity", Rule: "min", Param: "1", Message: "must be at least 1"})
+		case v.Items[i0].Quantity > 100:
+			errs = append(errs, &validator.FieldError{Field: "items[" + strconv.Itoa(i0) + "].quantity", Rule: "max", Param: "100", Message: "must be at most 100"})
+		}
+	}
+	switch {
+	case len(v.Tags) == 0:
+	case len(v.Tags) < 1:
+		errs = append(errs, &validator.FieldError{Field: "tags", Rule: "min", Param: "1", Message: "must have at least 1 elements"})
+	}
+	switch {
+	case !v.Newsletter && string(v.Plan) != "free":
+		errs = append(errs, &validator.FieldError{Field: "newsletter", Rule: "required_unless", Param: "Plan free", Message: "is required"})
+	}
+	switch {
+	case !(v.Discount >= 0):
+		errs = append(errs, &validator.FieldError{Field: "discount", Rule: "min", Param: "0", Message: "must be at least 0"})
+	case !(v.Discount <= 0.5):
+		errs = append(errs, &validator.FieldError{Field: "discount", Rule: "max", Param: "0.5", Message: "must be at most 0.5"})
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// Validate checks v against the validate tags of Booking and returns
+// validator.ValidationErrors listing every invalid field, or nil.
+func (v *Booking) Validate() error {
+	if v == nil {
+		return nil
+	}
+
+	var errs validator.ValidationErrors
+	switch {
+	case v.From == (time.Time{}):
+		errs = append(errs, &validator.FieldError{Field: "From", Rule: "required", Param: "", Message: "is required"})
+	}
+	switch {
+	case v.To == nil || *v.To == (time.Time{}):
+	case v.To.Before(v.From):
+		errs = append(errs, &validator.FieldError{Field: "To", Rule: "gtefield", Param: "From", Message: "must not be before From"})
+	}
+	switch {
+	case v.Guests == 0:
+		errs = append(errs, &validator.FieldError{Field: "Guests", Rule: "required", Param: "", Message: "is required"})
+	case v.Capacity < 0 || uint64(v.Capacity) < uint64(v.Guests):
+		errs = append(errs, &validator.FieldError{Field: "Guests", Rule: "ltefield", Param: "Capacity", Message: "must not be after Capacity"})
+	}
+	switch {
+	case !(v.Capacity == 2 || v.Capacity == 4 || v.Capacity == 8):
+		errs = append(errs, &validator.FieldError{Field: "Capacity", Rule: "oneof", Param: "2 4 8", Message: "must be one of 2 4 8"})
+	}
+	switch {
+	case len(v.Rooms) == 0:
+		errs = append(errs, &validator.FieldError{Field: "Rooms", Rule: "required", Param: "", Message: "is required"})
+	case len(v.Rooms) > 3:
+		errs = append(errs, &validator.FieldError{Field: "Rooms", Rule: "max", Param: "3", Message: "must have at most 3 elements"})
+	}
+	for i1 := range v.Rooms {
+		if v.Rooms[i1] != nil {
+			switch {
+			case v.Rooms[i1].Name == "":
+				errs = append(errs, &validator.FieldError{Field: "Rooms[" + strconv.Itoa(i1) + "].Name", Rule: "required", Param: "", Message: "is required"})
+			}
+			switch {
+			case v.Rooms[i1].Beds < 1:
+				errs = append(errs, &validator.FieldError{Field: "Rooms[" + strconv.Itoa(i1) + "].Beds", Rule: "min", Param: "1", Message: "must be at least 1"})
+			}
+		}
+	}
+	switch {
+	case v.Tier == "":
+	case !(string(v.Tier) == "standard" || string(v.Tier) == "suite"):
+		errs = append(errs, &validator.FieldError{Field: "Tier", Rule: "oneof", Param: "standard suite", Message: "must be one of standard suite"})
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// Validate checks v against the validate tags of Limits and returns
+// validator.ValidationErrors listing every invalid field, or nil.
+func (v *Limits) Validate() error {
+	if v == nil {
+		return nil
+	}
+
+	var errs validator.ValidationErrors
+	switch {
+	case v.Low < -9007199254740993:
+		errs = append(errs, &validator.FieldError{Field: "Low", Rule: "min", Param: "-9007199254740993", Message: "must be at least -9007199254740993"})
+	case v.Low >= 0 && uint64(v.Low) >= v.High:
+		errs = append(errs, &validator.FieldError{Field: "Low", Rule: "ltfield", Param: "High", Message: "must be before High"})
+	}
+	switch {
+	case v.High > 18446744073709551614:
+		errs = append(errs, &validator.FieldError{Field: "High", Rule: "max", Param: "18446744073709551614", Message: "must be at most 18446744073709551614"})
+	case float64(v.High) == float64(v.Ratio):
+		errs = append(errs, &validator.FieldError{Field: "High", Rule: "nefield", Param: "Ratio", Message: "must differ from Ratio"})
+	}
+	switch {
+	case !(float64(v.Ratio) >= 0):
+		errs = append(errs, &validator.FieldError{Field: "Ratio", Rule: "min", Param: "0", Message: "must be at least 0"})
+	case !(float64(v.Ratio) <= 1):
+		errs = append(errs, &validator.FieldError{Field: "Ratio", Rule: "max", Param: "1", Message: "must be at most 1"})
+	}
+	switch {
+	case !(v.Weight >= float64(v.Ratio)):
+		errs = append(errs, &validator.FieldError{Field: "Weight", Rule: "gtefield", Param: "Ratio", Message: "must not be before Ratio"})
+	}
+	switch {
+	case int64(v.Step) > 300:
+		errs = append(errs, &validator.FieldError{Field: "Step", Rule: "max", Param: "300", Message: "must be at most 300"})
+	}
+	switch {
+	case float64(v.Size) < -1:
+		errs = append(errs, &validator.FieldError{Field: "Size", Rule: "min", Param: "-1", Message: "must be at least -1"})
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+// Code generated by validator-gen; DO NOT EDIT.
+
+package example
+
+import (
+	"fmt"
+	"testing"
+
+	validator "github.com/MrWormHole/simple-validator"
+)
+
+func init() {
+	validator.RegisterValidation("lowercase", isLowercase)
+}
+
+func TestSignupValidate(t *testing.T) {
+	v := &Signup{}
+	if expected, actual := fmt.Sprint(validator.ValidateStruct(v)), fmt.Sprint(v.Validate()); actual != expected {
+		t.Errorf("Validate() = %s, ValidateStruct gives %s", actual, expected)
+	}
+}
+
+func FuzzSignupValidate(f *testing.F) {
+	f.Add("", "", "", "", "", 0, "", false, 0.0)
+	f.Fuzz(func(t *testing.T, email string, username string, password string, confirmPassword string, plan string, seats int, vatid string, newsletter bool, discount float64) {
+		v := &Signup{Email: email, Username: username, Password: password, ConfirmPassword: confirmPassword, Plan: Plan(plan), Seats: seats, VATID: vatid, Newsletter: newsletter, Discount: discount}
+		if expected, actual := fmt.Sprint(validator.ValidateStruct(v)), fmt.Sprint(v.Validate()); actual != expected {
+			t.Errorf("Validate() = %s, ValidateStruct gives %s", actual, expected)
+		}
+	})
+}
+
+func TestBookingValidate(t *testing.T) {
+	v := &Booking{}
+	if expected, actual := fmt.Sprint(validator.ValidateStruct(v)), fmt.Sprint(v.Validate()); actual != expected {
+		t.Errorf("Validate() = %s, ValidateStruct gives %s", actual, expected)
+	}
+}
+
+func FuzzBookingValidate(f *testing.F) {
+	f.Add(uint8(0), 0, "")
+	f.Fuzz(func(t *testing.T, guests uint8, capacity int, tier string) {
+		v := &Booking{Guests: guests, Capacity: capacity, Tier: Tier(tier)}
+		if expected, actual := fmt.Sprint(validator.ValidateStruct(v)), fmt.Sprint(v.Validate()); actual != expected {
+			t.Errorf("Validate() = %s, ValidateStruct gives %s", actual, expected)
+		}
+	})
+}
+
+func TestLimitsValidate(t *testing.T) {
+	v := &Limits{}
+	if expected, actual := fmt.Sprint(validator.ValidateStruct(v)), fmt.Sprint(v.Validate()); actual != expected {
+		t.Errorf("Validate() = %s, ValidateStruct gives %s", actual, expected)
+	}
+}
+
+func FuzzLimitsValidate(f *testing.F) {
+	f.Add(int64(0), uint64(0), float32(0), 0.0, int8(0), uint16(0))
+	f.Fuzz(func(t *testing.T, low int64, high uint64, ratio float32, weight float64, step int8, size uint16) {
+		v := &Limits{Low: low, High: high, Ratio: ratio, Weight: weight, Step: step, Size: size}
+		if expected, actual := fmt.Sprint(validator.ValidateStruct(v)), fmt.Sprint(v.Validate()); actual != expected {
+			t.Errorf("Validate() = %s, ValidateStruct gives %s", actual, expected)
+		}
+	})
+}
@@ -0,0 +1,95 @@
+// Command validator-gen generates reflection-free Validate methods from the
+// validate struct tags understood by validator.ValidateStruct. Add a directive
+// such as
+//
+//	//go:generate go run github.com/MrWormHole/simple-validator/cmd/validator-gen -type Signup,Address
+//
+// to a package and run go generate. For each type, validator-gen writes a
+//
+//	func (v *Signup) Validate() error
+//
+// method to validator_gen.go that checks the same rules as ValidateStruct by
+// calling the validators of the package directly, and returns the same
+// validator.ValidationErrors. Tests comparing both implementations are written
+// to validator_gen_test.go.
+//
+// Unknown rule names, references to unknown fields and rules that do not apply
+// to the type of their field are reported when generating. Validations
+// registered at runtime with validator.RegisterValidation are mapped to
+// functions of the package with -rule:
+//
+//	validator-gen -type Signup -rule lowercase=isLowercase
+//
+// where isLowercase is a func(string) bool, so that a misspelled function
+// name fails to compile. Interface fields are not descended into, and field
+// references in tags cannot cross pointers.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ruleFlags collects the -rule name=function flags.
+type ruleFlags map[string]string
+
+func (r ruleFlags) String() string {
+	return fmt.Sprint(map[string]string(r))
+}
+
+func (r ruleFlags) Set(value string) error {
+	name, function, ok := strings.Cut(value, "=")
+	if !ok || name == "" || function == "" {
+		return fmt.Errorf("expected name=function, got %q", value)
+	}
+	r[name] = function
+
+	return nil
+}
+
+func main() {
+	rules := ruleFlags{}
+	typeNames := flag.String("type", "", "comma-separated list of type names; default all structs with validate tags")
+	output := flag.String("output", "validator_gen.go", "output file name")
+	tests := flag.Bool("tests", true, "also write tests comparing the generated methods with ValidateStruct")
+	flag.Var(rules, "rule", "map a custom rule to a func(string) bool of the package, as name=function; repeatable")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: validator-gen [flags] [directory]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	dir := "."
+	if flag.NArg() > 1 {
+		flag.Usage()
+		os.Exit(2)
+	} else if flag.NArg() == 1 {
+		dir = flag.Arg(0)
+	}
+
+	var types []string
+	if *typeNames != "" {
+		types = strings.Split(*typeNames, ",")
+	}
+
+	testOutput := strings.TrimSuffix(*output, ".go") + "_test.go"
+	code, testCode, err := generate(dir, types, rules, []string{*output, testOutput})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "validator-gen:", err)
+		os.Exit(1)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, *output), code, 0o644); err != nil {
+		fmt.Fprintln(os.Stderr, "validator-gen:", err)
+		os.Exit(1)
+	}
+	if *tests {
+		if err := os.WriteFile(filepath.Join(dir, testOutput), testCode, 0o644); err != nil {
+			fmt.Fprintln(os.Stderr, "validator-gen:", err)
+			os.Exit(1)
+		}
+	}
+}
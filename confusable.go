@@ -0,0 +1,296 @@
+package validator
+
+import (
+	_ "embed"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// RestrictionLevel is a UTS #39 restriction level. Levels are ordered from the
+// most to the least restrictive.
+type RestrictionLevel int
+
+const (
+	ASCIIOnly RestrictionLevel = iota + 1
+	SingleScript
+	HighlyRestrictive
+	ModeratelyRestrictive
+	MinimallyRestrictive
+	// Unrestricted is never returned by RestrictionLevelOf, which does not
+	// check identifier profile membership, but can be passed to
+	// IsRestrictionLevel to accept any string.
+	Unrestricted
+)
+
+func (l RestrictionLevel) String() string {
+	switch l {
+	case ASCIIOnly:
+		return "ASCII-only"
+	case SingleScript:
+		return "single script"
+	case HighlyRestrictive:
+		return "highly restrictive"
+	case ModeratelyRestrictive:
+		return "moderately restrictive"
+	case MinimallyRestrictive:
+		return "minimally restrictive"
+	case Unrestricted:
+		return "unrestricted"
+	}
+
+	return "RestrictionLevel(" + strconv.Itoa(int(l)) + ")"
+}
+
+// Names of the UTS #39 augmented script sets. Han text can be written together
+// with Bopomofo, Hiragana and Katakana, or Hangul without being mixed-script.
+const (
+	scriptHanWithBopomofo = "Han with Bopomofo"
+	scriptJapanese        = "Japanese"
+	scriptKorean          = "Korean"
+)
+
+var (
+	//go:embed data/confusables.txt
+	confusablesData string
+
+	// confusables maps characters to their prototype, parsed from the
+	// embedded data on first use.
+	confusables = newLazyTable(parseConfusables)
+
+	scriptNames = newLazyTable(sortedScriptNames)
+
+	scriptAugmentations = map[string][]string{
+		"Han":      {scriptHanWithBopomofo, scriptJapanese, scriptKorean},
+		"Hiragana": {scriptJapanese},
+		"Katakana": {scriptJapanese},
+		"Hangul":   {scriptKorean},
+		"Bopomofo": {scriptHanWithBopomofo},
+	}
+)
+
+func parseConfusables() map[rune]string {
+	prototypes := map[rune]string{}
+	eachRecord(confusablesData, func(fields []string) {
+		var prototype strings.Builder
+		for _, field := range fields[1:] {
+			prototype.WriteRune(parseCodePoint(field))
+		}
+		prototypes[parseCodePoint(fields[0])] = prototype.String()
+	})
+
+	return prototypes
+}
+
+func parseCodePoint(hex string) rune {
+	r, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		panic("validator: malformed confusables data: " + hex)
+	}
+
+	return rune(r)
+}
+
+func sortedScriptNames() []string {
+	names := make([]string, 0, len(unicode.Scripts))
+	for name := range unicode.Scripts {
+		if name != "Common" && name != "Inherited" && name != "Latin" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// ScriptOf returns the Unicode script of r, such as Latin or Cyrillic, using
+// the script names of the unicode package. Unassigned code points belong to
+// the Unknown script.
+func ScriptOf(r rune) string {
+	switch {
+	case r < utf8.RuneSelf:
+		if c := byte(r) | 0x20; c >= 'a' && c <= 'z' {
+			return "Latin"
+		}
+		return "Common"
+	case unicode.Is(unicode.Latin, r):
+		return "Latin"
+	case unicode.Is(unicode.Common, r):
+		return "Common"
+	case unicode.Is(unicode.Inherited, r):
+		return "Inherited"
+	}
+
+	for _, name := range scriptNames.get() {
+		if unicode.Is(unicode.Scripts[name], r) {
+			return name
+		}
+	}
+
+	return "Unknown"
+}
+
+// Scripts returns the sorted set of scripts used by str, leaving out Common
+// and Inherited characters such as digits, punctuation and combining marks.
+func Scripts(str string) []string {
+	seen := map[string]struct{}{}
+	for _, r := range str {
+		if script := ScriptOf(r); script != "Common" && script != "Inherited" {
+			seen[script] = struct{}{}
+		}
+	}
+
+	scripts := make([]string, 0, len(seen))
+	for script := range seen {
+		scripts = append(scripts, script)
+	}
+	sort.Strings(scripts)
+
+	return scripts
+}
+
+// IsMixedScript reports whether str uses characters of more than one script
+// according to UTS #39: its resolved script set is empty. Common and Inherited
+// characters are compatible with every script, and Han may be combined with
+// Hiragana and Katakana, Hangul or Bopomofo, so 東京タワー is single-script
+// while pаypal with a Cyrillic а is not.
+func IsMixedScript(str string) bool {
+	resolved := resolvedScripts(str, "")
+	return resolved != nil && len(resolved) == 0
+}
+
+// IsSingleScript reports whether str is not mixed-script.
+func IsSingleScript(str string) bool {
+	return !IsMixedScript(str)
+}
+
+// IsAllowedScripts reports whether every character of str is a Common or
+// Inherited character or belongs to one of the given scripts, allowing callers
+// to permit specific script combinations such as Latin with Greek.
+func IsAllowedScripts(str string, scripts ...string) bool {
+	for _, r := range str {
+		script := ScriptOf(r)
+		if script != "Common" && script != "Inherited" && !containsString(scripts, script) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// RestrictionLevelOf returns the most restrictive UTS #39 restriction level
+// that str satisfies. Whether the characters are recommended for identifiers
+// is not checked, so strings that would be Unrestricted are reported as
+// MinimallyRestrictive.
+func RestrictionLevelOf(str string) RestrictionLevel {
+	ascii := true
+	for i := 0; i < len(str); i++ {
+		if str[i] >= utf8.RuneSelf {
+			ascii = false
+			break
+		}
+	}
+	if ascii {
+		return ASCIIOnly
+	}
+
+	if !IsMixedScript(str) {
+		return SingleScript
+	}
+
+	resolved := resolvedScripts(str, "Latin")
+	if len(resolved) == 0 {
+		return MinimallyRestrictive
+	}
+	for _, script := range []string{scriptJapanese, scriptHanWithBopomofo, scriptKorean} {
+		if _, ok := resolved[script]; ok {
+			return HighlyRestrictive
+		}
+	}
+	for _, script := range []string{"Cyrillic", "Greek"} {
+		if _, ok := resolved[script]; ok {
+			return MinimallyRestrictive
+		}
+	}
+
+	return ModeratelyRestrictive
+}
+
+// IsRestrictionLevel reports whether str is at least as restricted as level,
+// so that IsRestrictionLevel(username, validator.HighlyRestrictive) accepts
+// ASCII-only, single-script and highly restrictive names.
+func IsRestrictionLevel(str string, level RestrictionLevel) bool {
+	return RestrictionLevelOf(str) <= level
+}
+
+// resolvedScripts returns the UTS #39 resolved script set of str, ignoring
+// Common and Inherited characters and those of the ignored script. A nil set
+// means that every script is compatible with str.
+func resolvedScripts(str string, ignored string) map[string]struct{} {
+	var resolved map[string]struct{}
+	for _, r := range str {
+		script := ScriptOf(r)
+		if script == "Common" || script == "Inherited" || script == ignored {
+			continue
+		}
+
+		augmented := append([]string{script}, scriptAugmentations[script]...)
+		if resolved == nil {
+			resolved = make(map[string]struct{}, len(augmented))
+			for _, name := range augmented {
+				resolved[name] = struct{}{}
+			}
+			continue
+		}
+
+		for name := range resolved {
+			if !containsString(augmented, name) {
+				delete(resolved, name)
+			}
+		}
+		if len(resolved) == 0 {
+			return resolved
+		}
+	}
+
+	return resolved
+}
+
+func containsString(list []string, str string) bool {
+	for _, candidate := range list {
+		if candidate == str {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Skeleton returns the UTS #39 confusable skeleton of str: the NFD form with
+// every character replaced by its prototype from the Unicode confusables
+// table. Strings with equal skeletons look alike, so "paypal" and "pаypal"
+// (Cyrillic а) share the skeleton "paypal". Skeletons are only meant to be
+// compared and should not be displayed.
+func Skeleton(str string) string {
+	var b strings.Builder
+	prototypes := confusables.get()
+	for _, r := range norm.NFD.String(str) {
+		if prototype, ok := prototypes[r]; ok {
+			b.WriteString(prototype)
+			continue
+		}
+		b.WriteRune(r)
+	}
+
+	return norm.NFD.String(b.String())
+}
+
+// IsConfusable reports whether a and b are visually confusable, that is
+// whether they have the same skeleton. Identical strings are confusable.
+func IsConfusable(a string, b string) bool {
+	return Skeleton(a) == Skeleton(b)
+}
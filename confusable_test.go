@@ -0,0 +1,115 @@
+package validator_test
+
+import (
+	validator "github.com/MrWormHole/simple-validator"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestScripts(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal([]string{}, validator.Scripts("123 !?"))
+	assert.Equal([]string{"Latin"}, validator.Scripts("paypal"))
+	assert.Equal([]string{"Cyrillic", "Latin"}, validator.Scripts("pаypal"))
+	assert.Equal([]string{"Han", "Katakana"}, validator.Scripts("東京タワー"))
+	assert.Equal("Inherited", validator.ScriptOf('́'))
+	assert.Equal("Greek", validator.ScriptOf('α'))
+}
+
+func TestIsMixedScript(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []testCase{
+		{"", false},
+		{"paypal", false},
+		{"café", false},
+		{"café", false},
+		{"user_123", false},
+		{"пример", false},
+		{"東京タワー", false},
+		{"ひらがなカタカナ漢字", false},
+		{"한국어漢字", false},
+		{"ㄅㄆ漢字", false},
+		{"pаypal", true},
+		{"αβcd", true},
+		{"ひらがな한글", true},
+		{"ㄅㄆカタカナ", true},
+	}
+
+	for _, t := range testCases {
+		actual := validator.IsMixedScript(t.param)
+		assert.Equal(t.expected, actual, t.param)
+		assert.Equal(!t.expected, validator.IsSingleScript(t.param), t.param)
+	}
+}
+
+func TestIsAllowedScripts(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.True(validator.IsAllowedScripts("abc-123", "Latin"))
+	assert.True(validator.IsAllowedScripts("alpha-α", "Latin", "Greek"))
+	assert.False(validator.IsAllowedScripts("pаypal", "Latin", "Greek"))
+	assert.False(validator.IsAllowedScripts("abc"))
+}
+
+func TestRestrictionLevelOf(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []struct {
+		param    string
+		expected validator.RestrictionLevel
+	}{
+		{"paypal", validator.ASCIIOnly},
+		{"", validator.ASCIIOnly},
+		{"café", validator.SingleScript},
+		{"пример", validator.SingleScript},
+		{"東京タワー", validator.SingleScript},
+		{"tokyo東京タワー", validator.HighlyRestrictive},
+		{"seoul서울漢字", validator.HighlyRestrictive},
+		{"abcㄅㄆ漢字", validator.HighlyRestrictive},
+		{"abcالعربية", validator.ModeratelyRestrictive},
+		{"abcहिन्दी", validator.ModeratelyRestrictive},
+		{"pаypal", validator.MinimallyRestrictive},
+		{"alphaβ", validator.MinimallyRestrictive},
+		{"abcالعربيةहिन्दी", validator.MinimallyRestrictive},
+	}
+
+	for _, t := range testCases {
+		actual := validator.RestrictionLevelOf(t.param)
+		assert.Equal(t.expected, actual, t.param)
+	}
+
+	assert.True(validator.IsRestrictionLevel("tokyo東京", validator.HighlyRestrictive))
+	assert.False(validator.IsRestrictionLevel("pаypal", validator.ModeratelyRestrictive))
+	assert.True(validator.IsRestrictionLevel("pаypal", validator.Unrestricted))
+	assert.Equal("highly restrictive", validator.HighlyRestrictive.String())
+	assert.Equal("RestrictionLevel(42)", validator.RestrictionLevel(42).String())
+}
+
+func TestIsConfusable(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []struct {
+		a        string
+		b        string
+		expected bool
+	}{
+		{"paypal", "paypal", true},
+		{"paypal", "pаypal", true},
+		{"paypal", "paypa1", true},
+		{"scope", "ѕсоре", true},
+		{"rn", "m", true},
+		{"0", "O", true},
+		{"café", "café", true},
+		{"paypal", "paypai", false},
+		{"google", "gooogle", false},
+	}
+
+	for _, t := range testCases {
+		actual := validator.IsConfusable(t.a, t.b)
+		assert.Equal(t.expected, actual, t.a+" "+t.b)
+	}
+
+	assert.Equal(validator.Skeleton("paypal"), validator.Skeleton("раура1"))
+}
@@ -0,0 +1,172 @@
+package validator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CronError describes the field of a cron expression that failed validation.
+type CronError struct {
+	Field  string
+	Value  string
+	Reason string
+}
+
+func (e *CronError) Error() string {
+	return fmt.Sprintf("cron: invalid %s field %q: %s", e.Field, e.Value, e.Reason)
+}
+
+type cronField struct {
+	name     string
+	min, max int
+	names    map[string]int
+	question bool
+}
+
+var (
+	cronMonthNames = map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}
+	cronDayNames = map[string]int{
+		"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+	}
+
+	cronSecond     = cronField{name: "second", min: 0, max: 59}
+	cronMinute     = cronField{name: "minute", min: 0, max: 59}
+	cronHour       = cronField{name: "hour", min: 0, max: 23}
+	cronDayOfMonth = cronField{name: "day-of-month", min: 1, max: 31, question: true}
+	cronMonth      = cronField{name: "month", min: 1, max: 12, names: cronMonthNames}
+	cronDayOfWeek  = cronField{name: "day-of-week", min: 0, max: 7, names: cronDayNames, question: true}
+
+	cronDescriptors = map[string]struct{}{
+		"@yearly": {}, "@annually": {}, "@monthly": {}, "@weekly": {},
+		"@daily": {}, "@midnight": {}, "@hourly": {},
+	}
+)
+
+func IsCron(str string) bool {
+	return ValidateCron(str) == nil
+}
+
+// ValidateCron checks a cron expression in the standard 5-field form, the
+// 6-field form with a leading seconds field, or a descriptor such as @hourly
+// or @every 1h30m. Fields accept *, values, names (JAN, MON), ranges, lists and
+// /steps; day-of-month and day-of-week also accept ?. The returned error is a
+// *CronError naming the offending field.
+func ValidateCron(str string) error {
+	expression := strings.TrimSpace(str)
+
+	if strings.HasPrefix(expression, "@") {
+		return validateCronDescriptor(expression)
+	}
+
+	fields := strings.Fields(expression)
+	var layout []cronField
+	switch len(fields) {
+	case 5:
+		layout = []cronField{cronMinute, cronHour, cronDayOfMonth, cronMonth, cronDayOfWeek}
+	case 6:
+		layout = []cronField{cronSecond, cronMinute, cronHour, cronDayOfMonth, cronMonth, cronDayOfWeek}
+	default:
+		return &CronError{Field: "expression", Value: str, Reason: fmt.Sprintf("expected 5 or 6 fields, got %d", len(fields))}
+	}
+
+	for i, field := range layout {
+		if reason := field.validate(fields[i]); reason != "" {
+			return &CronError{Field: field.name, Value: fields[i], Reason: reason}
+		}
+	}
+
+	return nil
+}
+
+func validateCronDescriptor(expression string) error {
+	if _, ok := cronDescriptors[expression]; ok {
+		return nil
+	}
+
+	if strings.HasPrefix(expression, "@every ") {
+		d, err := time.ParseDuration(strings.TrimSpace(strings.TrimPrefix(expression, "@every ")))
+		if err != nil || d <= 0 {
+			return &CronError{Field: "descriptor", Value: expression, Reason: "expected a positive duration"}
+		}
+
+		return nil
+	}
+
+	return &CronError{Field: "descriptor", Value: expression, Reason: "unknown descriptor"}
+}
+
+func (f cronField) validate(value string) string {
+	if value == "?" {
+		if !f.question {
+			return "? is only allowed in day-of-month and day-of-week"
+		}
+
+		return ""
+	}
+
+	for _, item := range strings.Split(value, ",") {
+		if reason := f.validateItem(item); reason != "" {
+			return reason
+		}
+	}
+
+	return ""
+}
+
+func (f cronField) validateItem(item string) string {
+	if item == "" {
+		return "empty list item"
+	}
+
+	rangePart, stepPart, hasStep := strings.Cut(item, "/")
+	if hasStep {
+		step, err := strconv.Atoi(stepPart)
+		if err != nil || !isASCIIDigits(stepPart) || step < 1 || step > f.max {
+			return fmt.Sprintf("step %q must be between 1 and %d", stepPart, f.max)
+		}
+	}
+
+	if rangePart == "*" {
+		return ""
+	}
+
+	low, high, isRange := strings.Cut(rangePart, "-")
+	start, reason := f.parseValue(low)
+	if reason != "" {
+		return reason
+	}
+	if !isRange {
+		return ""
+	}
+
+	end, reason := f.parseValue(high)
+	if reason != "" {
+		return reason
+	}
+	if start > end {
+		return fmt.Sprintf("range start %d is after range end %d", start, end)
+	}
+
+	return ""
+}
+
+func (f cronField) parseValue(value string) (int, string) {
+	if n, ok := f.names[strings.ToUpper(value)]; ok {
+		return n, ""
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil || !isASCIIDigits(value) {
+		return 0, fmt.Sprintf("%q is not a number", value)
+	}
+	if n < f.min || n > f.max {
+		return 0, fmt.Sprintf("value %d out of range %d-%d", n, f.min, f.max)
+	}
+
+	return n, ""
+}
@@ -0,0 +1,64 @@
+package validator_test
+
+import (
+	validator "github.com/MrWormHole/simple-validator"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestIsCron(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []testCase{
+		{"* * * * *", true},
+		{"*/15 0-6 1,15 * MON-FRI", true},
+		{"0 12 * JAN,jul sun", true},
+		{"0 0 ? * 7", true},
+		{"5/10 * * * *", true},
+		{"0 30 9 * * 1-5", true},
+		{"@hourly", true},
+		{"@annually", true},
+		{"@every 1h30m", true},
+		{"@every -1s", false},
+		{"@fortnightly", false},
+		{"* * * *", false},
+		{"* * * * * * *", false},
+		{"60 * * * *", false},
+		{"* 24 * * *", false},
+		{"* * 0 * *", false},
+		{"* * * 13 *", false},
+		{"* * * * 8", false},
+		{"? * * * *", false},
+		{"*/0 * * * *", false},
+		{"5-1 * * * *", false},
+		{"1,,2 * * * *", false},
+		{"* * * FOO *", false},
+		{"", false},
+	}
+
+	for _, t := range testCases {
+		actual := validator.IsCron(t.param)
+		assert.Equal(t.expected, actual, t.param)
+	}
+}
+
+func TestValidateCron(t *testing.T) {
+	assert := assert.New(t)
+
+	err := validator.ValidateCron("0 25 * * *")
+	var cronErr *validator.CronError
+	assert.ErrorAs(err, &cronErr)
+	assert.Equal("hour", cronErr.Field)
+	assert.Equal("25", cronErr.Value)
+	assert.EqualError(err, `cron: invalid hour field "25": value 25 out of range 0-23`)
+
+	err = validator.ValidateCron("0 0 * * * 9")
+	assert.ErrorAs(err, &cronErr)
+	assert.Equal("day-of-week", cronErr.Field)
+
+	err = validator.ValidateCron("* *")
+	assert.ErrorAs(err, &cronErr)
+	assert.Equal("expression", cronErr.Field)
+
+	assert.NoError(validator.ValidateCron("0 0 1 1 *"))
+}
@@ -0,0 +1,333 @@
+package validator
+
+import (
+	"strings"
+	"time"
+)
+
+// Clock returns the current time. A nil Clock means time.Now.
+type Clock func() time.Time
+
+func (c Clock) now() time.Time {
+	if c == nil {
+		return time.Now()
+	}
+
+	return c()
+}
+
+// IsRFC3339 accepts RFC 3339 timestamps with or without fractional seconds.
+func IsRFC3339(str string) bool {
+	_, err := time.Parse(time.RFC3339, str)
+	return err == nil
+}
+
+// IsRFC3339Nano accepts RFC 3339 timestamps carrying fractional seconds.
+func IsRFC3339Nano(str string) bool {
+	if _, err := time.Parse(time.RFC3339Nano, str); err != nil {
+		return false
+	}
+
+	return len(str) > 19 && str[19] == '.'
+}
+
+func IsGoDuration(str string) bool {
+	_, err := time.ParseDuration(str)
+	return err == nil
+}
+
+func IsDateLayout(str string, layout string) bool {
+	_, err := time.Parse(layout, str)
+	return err == nil
+}
+
+// IsISO8601Duration accepts durations such as P3Y6M4DT12H30M5S, P2W or PT0,5S.
+// Only the smallest component present may carry a decimal fraction.
+func IsISO8601Duration(str string) bool {
+	matches := iso8601DurationRegex.FindStringSubmatch(str)
+	if matches == nil {
+		return false
+	}
+
+	components := append(matches[1:5:5], matches[6:]...)
+	last := -1
+	for i, component := range components {
+		if component != "" {
+			last = i
+		}
+	}
+	if last == -1 {
+		return false
+	}
+	if matches[5] == "T" && matches[6] == "" && matches[7] == "" && matches[8] == "" {
+		return false
+	}
+
+	for i := 0; i < last; i++ {
+		if strings.ContainsAny(components[i], ".,") {
+			return false
+		}
+	}
+
+	return true
+}
+
+type iso8601Format int
+
+const (
+	iso8601Any iso8601Format = iota
+	iso8601Basic
+	iso8601Extended
+)
+
+// IsISO8601 accepts ISO 8601 calendar dates (2006-01-02, 20060102, 2006-01),
+// week dates (2006-W01-1, 2006W011), ordinal dates (2006-002, 2006002), times
+// (15:04, 15:04:05.999, 150405, with an optional leading T) with optional Z or
+// numeric offsets, and date-time combinations joined by T. Basic and extended
+// formats may not be mixed within one value, and basic format times need the
+// leading T to disambiguate them from dates. Without the T, a time also needs
+// hours and minutes: a bare 12 reads as a century and 12-05 as a truncated
+// year and month, so both are rejected; T12 and T12-05 are accepted.
+func IsISO8601(str string) bool {
+	if str == "" {
+		return false
+	}
+
+	if str[0] == 'T' {
+		return isISO8601Time(str[1:], iso8601Any)
+	}
+
+	date, clock, hasTime := strings.Cut(str, "T")
+	format, ok := parseISO8601Date(date)
+	if !hasTime {
+		if ok {
+			return true
+		}
+		clock := str
+		if i := strings.IndexAny(str, "Z+-"); i != -1 {
+			clock = str[:i]
+		}
+		return strings.Contains(clock, ":") && isISO8601Time(str, iso8601Extended)
+	}
+
+	return ok && isISO8601Time(clock, format)
+}
+
+func parseISO8601Date(str string) (iso8601Format, bool) {
+	if len(str) < 4 || !isASCIIDigits(str[:4]) {
+		return iso8601Any, false
+	}
+
+	year := atoi(str[:4])
+	rest := str[4:]
+	format := iso8601Basic
+	if rest == "" {
+		return iso8601Any, true
+	}
+	if rest[0] == '-' {
+		format = iso8601Extended
+		rest = rest[1:]
+	}
+
+	if rest != "" && rest[0] == 'W' {
+		week := rest[1:]
+		day := ""
+		switch {
+		case len(week) == 2:
+		case format == iso8601Extended && len(week) == 4 && week[2] == '-':
+			week, day = week[:2], week[3:]
+		case format == iso8601Basic && len(week) == 3:
+			week, day = week[:2], week[2:]
+		default:
+			return format, false
+		}
+		if !isASCIIDigits(week) || !isASCIIDigits(day) {
+			return format, false
+		}
+		if w := atoi(week); w < 1 || w > isoWeeksInYear(year) {
+			return format, false
+		}
+		if day != "" && (day < "1" || day > "7") {
+			return format, false
+		}
+
+		return format, true
+	}
+
+	switch {
+	case len(rest) == 3 && isASCIIDigits(rest):
+		days := 365
+		if isLeapYear(year) {
+			days = 366
+		}
+		day := atoi(rest)
+
+		return format, day >= 1 && day <= days
+	case format == iso8601Extended && len(rest) == 2 && isASCIIDigits(rest):
+		month := atoi(rest)
+
+		return format, month >= 1 && month <= 12
+	case format == iso8601Extended && len(rest) == 5 && rest[2] == '-':
+		return format, isASCIIDigits(rest[:2]) && isASCIIDigits(rest[3:]) && isValidDate(year, atoi(rest[:2]), atoi(rest[3:]))
+	case format == iso8601Basic && len(rest) == 4 && isASCIIDigits(rest):
+		return format, isValidDate(year, atoi(rest[:2]), atoi(rest[2:]))
+	}
+
+	return format, false
+}
+
+func isISO8601Time(str string, format iso8601Format) bool {
+	clock, zone := str, ""
+	if i := strings.IndexAny(str, "Z+-"); i != -1 {
+		clock, zone = str[:i], str[i:]
+	}
+
+	clock, fraction, hasFraction := cutAny(clock, ".,")
+	if hasFraction && (fraction == "" || !isASCIIDigits(fraction)) {
+		return false
+	}
+
+	var fields []string
+	switch {
+	case len(clock) == 2:
+		fields = []string{clock}
+	case strings.Contains(clock, ":"):
+		if format == iso8601Basic {
+			return false
+		}
+		format = iso8601Extended
+		fields = strings.Split(clock, ":")
+	default:
+		if format == iso8601Extended || len(clock)%2 != 0 {
+			return false
+		}
+		format = iso8601Basic
+		for i := 0; i < len(clock); i += 2 {
+			fields = append(fields, clock[i:i+2])
+		}
+	}
+
+	if len(fields) == 0 || len(fields) > 3 {
+		return false
+	}
+	for _, field := range fields {
+		if len(field) != 2 || !isASCIIDigits(field) {
+			return false
+		}
+	}
+
+	hour, minute, second := atoi(fields[0]), 0, 0
+	if len(fields) > 1 {
+		minute = atoi(fields[1])
+	}
+	if len(fields) > 2 {
+		second = atoi(fields[2])
+	}
+
+	switch {
+	case hour == 24:
+		if minute != 0 || second != 0 || strings.Trim(fraction, "0") != "" {
+			return false
+		}
+	case hour > 24, minute > 59, second > 60:
+		return false
+	case second == 60 && (hour != 23 || minute != 59):
+		return false
+	}
+
+	return isISO8601Zone(zone, format)
+}
+
+func isISO8601Zone(zone string, format iso8601Format) bool {
+	if zone == "" || zone == "Z" {
+		return true
+	}
+
+	offset := zone[1:]
+	switch {
+	case len(offset) == 2:
+	case len(offset) == 5 && offset[2] == ':' && format != iso8601Basic:
+		offset = offset[:2] + offset[3:]
+	case len(offset) == 4 && format != iso8601Extended:
+	default:
+		return false
+	}
+
+	if !isASCIIDigits(offset) || atoi(offset[:2]) > 23 {
+		return false
+	}
+
+	return len(offset) == 2 || atoi(offset[2:]) <= 59
+}
+
+func cutAny(str string, chars string) (before string, after string, found bool) {
+	if i := strings.IndexAny(str, chars); i != -1 {
+		return str[:i], str[i+1:], true
+	}
+
+	return str, "", false
+}
+
+func atoi(digits string) int {
+	n := 0
+	for i := 0; i < len(digits); i++ {
+		n = n*10 + int(digits[i]-'0')
+	}
+
+	return n
+}
+
+func isLeapYear(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+func isValidDate(year int, month int, day int) bool {
+	if month < 1 || month > 12 || day < 1 {
+		return false
+	}
+
+	return day <= time.Date(year, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func isoWeeksInYear(year int) int {
+	_, week := time.Date(year, time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+	return week
+}
+
+// IsDateBefore reports whether str, parsed with layout, is strictly before t.
+func IsDateBefore(str string, layout string, t time.Time) bool {
+	date, err := time.Parse(layout, str)
+	return err == nil && date.Before(t)
+}
+
+// IsDateAfter reports whether str, parsed with layout, is strictly after t.
+func IsDateAfter(str string, layout string, t time.Time) bool {
+	date, err := time.Parse(layout, str)
+	return err == nil && date.After(t)
+}
+
+// IsDateBetween reports whether str, parsed with layout, lies within the
+// inclusive range [from, to].
+func IsDateBetween(str string, layout string, from time.Time, to time.Time) bool {
+	date, err := time.Parse(layout, str)
+	return err == nil && !date.Before(from) && !date.After(to)
+}
+
+// IsPastDate reports whether str, parsed with layout, is before the clock's
+// current time.
+func IsPastDate(str string, layout string, clock Clock) bool {
+	return IsDateBefore(str, layout, clock.now())
+}
+
+// IsFutureDate reports whether str, parsed with layout, is after the clock's
+// current time.
+func IsFutureDate(str string, layout string, clock Clock) bool {
+	return IsDateAfter(str, layout, clock.now())
+}
+
+// IsDateWithin reports whether str, parsed with layout, is no further than d
+// away from the clock's current time in either direction.
+func IsDateWithin(str string, layout string, d time.Duration, clock Clock) bool {
+	now := clock.now()
+	return IsDateBetween(str, layout, now.Add(-d), now.Add(d))
+}
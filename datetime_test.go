@@ -0,0 +1,181 @@
+package validator_test
+
+import (
+	validator "github.com/MrWormHole/simple-validator"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestIsRFC3339(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []testCase{
+		{"2006-01-02T15:04:05Z", true},
+		{"2006-01-02T15:04:05+07:00", true},
+		{"2006-01-02T15:04:05.999Z", true},
+		{"2006-01-02 15:04:05Z", false},
+		{"2006-01-02T15:04:05", false},
+		{"2006-02-30T15:04:05Z", false},
+		{"", false},
+	}
+
+	for _, t := range testCases {
+		actual := validator.IsRFC3339(t.param)
+		assert.Equal(t.expected, actual, t.param)
+	}
+}
+
+func TestIsRFC3339Nano(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []testCase{
+		{"2006-01-02T15:04:05.999999999Z", true},
+		{"2006-01-02T15:04:05.1-03:00", true},
+		{"2006-01-02T15:04:05Z", false},
+		{"2006-01-02T15:04:05.Z", false},
+		{"", false},
+	}
+
+	for _, t := range testCases {
+		actual := validator.IsRFC3339Nano(t.param)
+		assert.Equal(t.expected, actual, t.param)
+	}
+}
+
+func TestIsISO8601(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []testCase{
+		{"2023", true},
+		{"2023-04", true},
+		{"2023-04-05", true},
+		{"20230405", true},
+		{"2024-02-29", true},
+		{"2023-02-29", false},
+		{"2023-13-01", false},
+		{"202304", false},
+		{"2023-0405", false},
+		{"2020-W53", true},
+		{"2021-W53", false},
+		{"2023-W01-1", true},
+		{"2023W017", true},
+		{"2023-W01-8", false},
+		{"2023W01-1", false},
+		{"2023-365", true},
+		{"2023-366", false},
+		{"2024366", true},
+		{"2023-000", false},
+		{"2023-04-05T12:30", true},
+		{"2023-04-05T12:30:45.123Z", true},
+		{"2023-04-05T12:30:45,5+02:00", true},
+		{"2023-04-05T12:30:45-0530", false},
+		{"20230405T123045Z", true},
+		{"20230405T123045+0530", true},
+		{"20230405T12:30:45", false},
+		{"2023-04-05T123045", false},
+		{"2023-04-05T24:00", true},
+		{"2023-04-05T24:00:01", false},
+		{"2023-04-05T23:59:60Z", true},
+		{"2023-04-05T12:59:60Z", false},
+		{"2023-04-05T12:60", false},
+		{"2023-04-05T", false},
+		{"2023-04-05T12:30+25:00", false},
+		{"T12:30", true},
+		{"12:30:45Z", true},
+		{"12:30-05", true},
+		{"12", false},
+		{"12Z", false},
+		{"12-05", false},
+		{"12+02:00", false},
+		{"T12", true},
+		{"T12-05", true},
+		{"123", false},
+		{"", false},
+	}
+
+	for _, t := range testCases {
+		actual := validator.IsISO8601(t.param)
+		assert.Equal(t.expected, actual, t.param)
+	}
+}
+
+func TestIsISO8601Duration(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []testCase{
+		{"P3Y6M4DT12H30M5S", true},
+		{"P1M", true},
+		{"PT1M", true},
+		{"P2W", true},
+		{"PT0,5S", true},
+		{"P0.5Y", true},
+		{"PT36H", true},
+		{"P1DT", false},
+		{"P", false},
+		{"PT", false},
+		{"P0.5Y1M", false},
+		{"P1S", false},
+		{"3Y", false},
+		{"P-1D", false},
+		{"", false},
+	}
+
+	for _, t := range testCases {
+		actual := validator.IsISO8601Duration(t.param)
+		assert.Equal(t.expected, actual, t.param)
+	}
+}
+
+func TestIsGoDuration(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []testCase{
+		{"1h30m", true},
+		{"-1.5s", true},
+		{"300ms", true},
+		{"0", true},
+		{"1d", false},
+		{"", false},
+	}
+
+	for _, t := range testCases {
+		actual := validator.IsGoDuration(t.param)
+		assert.Equal(t.expected, actual, t.param)
+	}
+}
+
+func TestIsDateLayout(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.True(validator.IsDateLayout("02/01/2006", "02/01/2006"))
+	assert.True(validator.IsDateLayout("31/12/1999", "02/01/2006"))
+	assert.False(validator.IsDateLayout("12/31/1999", "02/01/2006"))
+	assert.False(validator.IsDateLayout("", "2006-01-02"))
+}
+
+func TestDateRanges(t *testing.T) {
+	assert := assert.New(t)
+
+	const layout = "2006-01-02"
+	now := time.Date(2023, time.June, 15, 12, 0, 0, 0, time.UTC)
+	clock := validator.Clock(func() time.Time { return now })
+
+	assert.True(validator.IsPastDate("1990-01-01", layout, clock))
+	assert.False(validator.IsPastDate("2030-01-01", layout, clock))
+	assert.False(validator.IsPastDate("not a date", layout, clock))
+	assert.True(validator.IsFutureDate("2023-06-16", layout, clock))
+	assert.False(validator.IsFutureDate("2023-06-15", layout, clock))
+	assert.True(validator.IsPastDate("1990-01-01", layout, nil))
+
+	assert.True(validator.IsDateWithin("2023-06-10", layout, 7*24*time.Hour, clock))
+	assert.False(validator.IsDateWithin("2023-06-01", layout, 7*24*time.Hour, clock))
+
+	from := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2023, time.December, 31, 0, 0, 0, 0, time.UTC)
+	assert.True(validator.IsDateBetween("2023-01-01", layout, from, to))
+	assert.True(validator.IsDateBetween("2023-12-31", layout, from, to))
+	assert.False(validator.IsDateBetween("2024-01-01", layout, from, to))
+	assert.True(validator.IsDateBefore("2022-12-31", layout, from))
+	assert.True(validator.IsDateAfter("2024-01-01", layout, to))
+}
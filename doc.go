@@ -0,0 +1,63 @@
+// Package validator provides string validators for common formats, from
+// e-mail addresses and UUIDs to postal codes, dates, passwords and HTML.
+//
+// Most validators are functions of the form IsX(str string) bool. Validators
+// that can explain a rejection have a ValidateX, ParseX or CheckX counterpart
+// returning an error or a list of violations. Values of other types, such as
+// numbers, times and slices, are checked with generic rules like Between, OneOf
+// and Unique, and Predicate lifts the string validators into the same form.
+// ValidateStruct checks whole structs against validate tags, including rules
+// that relate fields to each other, and cmd/validator-gen generates
+// reflection-free Validate methods from the same tags. ValidateValue applies
+// such a tag to a single value, and package batch applies a tag per column to
+// CSV and JSONL datasets and package httpvalidate to the inputs of HTTP
+// requests. cmd/simple-validator makes the validators available to shell
+// scripts.
+//
+// # Input size limits
+//
+// The validators accept input of any length by default. SetMaxInputLength
+// sets a global limit in bytes above which the format validators, from IsAlpha
+// to IsHTMLEncoded, whether they match a regular expression or are
+// hand-written, the other regular expression based validators such as
+// IsPostalCode, and the HTML functions reject their input without inspecting
+// it. The other validators, such as the number, date and time,
+// password, ISO code and struct validators, are not subject to it.
+// WithMaxLength adds a per-call limit to any validator, on top of the global
+// limit, and distinguishes inputs that are too long, reported as ErrTooLong,
+// from invalid ones, reported as ErrInvalid:
+//
+//	validator.SetMaxInputLength(1 << 20)
+//
+//	validateEmail := validator.WithMaxLength(254, validator.IsEmail)
+//	if err := validateEmail(field); errors.Is(err, validator.ErrTooLong) {
+//		...
+//	}
+//
+// The streaming validators, such as ValidateBase64Reader, run in constant
+// memory and are not subject to the global limit.
+//
+// # Complexity
+//
+// Regular expressions are matched with the standard library regexp package,
+// which guarantees time linear in the length of the input whatever the
+// pattern, so no input can cause catastrophic backtracking. Every pattern of
+// the package is checked for linear behavior on adversarial input in
+// redos_test.go. Worst-case time for an input of n bytes:
+//
+//	O(n)          all IsX validators of validator.go, the date and time, geo,
+//	              cron, rrule, semver, postal code, ISO code, encoding,
+//	              normalization, length, script and alphabet validators, the
+//	              number validators not listed below, Skeleton, IsHTML,
+//	              CheckHTML and SanitizeHTML
+//	O(n)          the streaming validators, in constant memory
+//	O(n)          EstimatePassword and CheckPassword, which only look for
+//	              dictionary words, repeats and sequences of up to 64
+//	              characters in the first 256 characters of the password
+//	O(n²)         IsBigFloat, IsNumericMin, IsNumericMax and
+//	              IsNumericBetween, which convert the decimal digits to an
+//	              exact rational; exponents are bounded separately
+//
+// Inputs that may be large and come from untrusted sources should be bounded
+// with SetMaxInputLength or WithMaxLength before reaching the last group.
+package validator
@@ -0,0 +1,203 @@
+package validator
+
+import (
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// SyntaxError reports malformed input and the byte offset at which it was
+// detected.
+type SyntaxError struct {
+	Offset int64
+	Reason string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("syntax error at offset %d: %s", e.Offset, e.Reason)
+}
+
+// maxEntityNameLength is the length of the longest named character reference
+// in the HTML5 table (&CounterClockwiseContourIntegral;).
+const maxEntityNameLength = 32
+
+// IsPercentEncoded reports whether the whole string is well-formed percent
+// encoding: every % starts a %XX escape and no character that must be escaped
+// (space, controls, non-ASCII and "<>\^`{|}) appears literally.
+func IsPercentEncoded(str string) bool {
+	return checkPercentEncoding(str) == nil
+}
+
+// DecodePercent decodes a percent-encoded string. Unlike url.QueryUnescape it
+// leaves + untouched and reports the offset of the first malformed escape or
+// literal character as a *SyntaxError.
+func DecodePercent(str string) (string, error) {
+	if err := checkPercentEncoding(str); err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	b.Grow(len(str))
+	for i := 0; i < len(str); i++ {
+		if str[i] == '%' {
+			b.WriteByte(unhex(str[i+1])<<4 | unhex(str[i+2]))
+			i += 2
+			continue
+		}
+		b.WriteByte(str[i])
+	}
+
+	return b.String(), nil
+}
+
+// IsDoublePercentEncoded reports whether str contains an escape that was
+// percent-encoded twice, such as %253C for <.
+func IsDoublePercentEncoded(str string) bool {
+	for i := strings.Index(str, "%25"); i != -1; {
+		if i+4 < len(str) && isHex(str[i+3]) && isHex(str[i+4]) {
+			return true
+		}
+		next := strings.Index(str[i+3:], "%25")
+		if next == -1 {
+			break
+		}
+		i += 3 + next
+	}
+
+	return false
+}
+
+func checkPercentEncoding(str string) error {
+	for i := 0; i < len(str); i++ {
+		c := str[i]
+		switch {
+		case c == '%':
+			if i+2 >= len(str) || !isHex(str[i+1]) || !isHex(str[i+2]) {
+				return &SyntaxError{Offset: int64(i), Reason: "% not followed by two hexadecimal digits"}
+			}
+			i += 2
+		case c <= ' ' || c >= 0x7f || strings.IndexByte("\"<>\\^`{|}", c) != -1:
+			return &SyntaxError{Offset: int64(i), Reason: fmt.Sprintf("character %q must be percent-encoded", c)}
+		}
+	}
+
+	return nil
+}
+
+// IsHTMLEntityEncoded reports whether the whole string is well-formed HTML
+// text: every & starts a named character reference from the HTML5 table or a
+// numeric reference to a valid code point, each terminated by a semicolon, and
+// no literal < or > appears.
+func IsHTMLEntityEncoded(str string) bool {
+	_, err := DecodeHTMLEntities(str)
+	return err == nil
+}
+
+// DecodeHTMLEntities decodes the character references of a strictly encoded
+// HTML string, reporting the offset of the first malformed reference or
+// literal < or > as a *SyntaxError.
+func DecodeHTMLEntities(str string) (string, error) {
+	var b strings.Builder
+	b.Grow(len(str))
+
+	for i := 0; i < len(str); i++ {
+		switch str[i] {
+		case '<', '>':
+			return "", &SyntaxError{Offset: int64(i), Reason: fmt.Sprintf("literal %q must be escaped", str[i])}
+		case '&':
+			decoded, length, reason := decodeCharacterReference(str[i:])
+			if reason != "" {
+				return "", &SyntaxError{Offset: int64(i), Reason: reason}
+			}
+			b.WriteString(decoded)
+			i += length - 1
+		default:
+			b.WriteByte(str[i])
+		}
+	}
+
+	return b.String(), nil
+}
+
+// IsDoubleHTMLEncoded reports whether str contains a character reference whose
+// ampersand was itself escaped, such as &amp;lt; or &amp;#60;.
+func IsDoubleHTMLEncoded(str string) bool {
+	for i := strings.Index(str, "&amp;"); i != -1; {
+		if _, _, reason := decodeCharacterReference("&" + str[i+len("&amp;"):]); reason == "" {
+			return true
+		}
+		next := strings.Index(str[i+1:], "&amp;")
+		if next == -1 {
+			break
+		}
+		i += 1 + next
+	}
+
+	return false
+}
+
+// decodeCharacterReference decodes the reference at the start of str, which
+// begins with &, and returns its value and length in bytes.
+func decodeCharacterReference(str string) (string, int, string) {
+	end := strings.IndexByte(str, ';')
+	if end == -1 || end > maxEntityNameLength+2 {
+		return "", 0, "character reference not terminated by ;"
+	}
+
+	reference := str[1:end]
+	if strings.HasPrefix(reference, "#") {
+		digits, base := reference[1:], 10
+		if strings.HasPrefix(digits, "x") || strings.HasPrefix(digits, "X") {
+			digits, base = digits[1:], 16
+		}
+		if digits == "" || base == 10 && !isASCIIDigits(digits) || base == 16 && !isHexString(digits) {
+			return "", 0, "malformed numeric character reference"
+		}
+
+		codePoint, err := strconv.ParseUint(digits, base, 32)
+		r := rune(codePoint)
+		if err != nil || r == 0 || !utf8.ValidRune(r) {
+			return "", 0, "numeric character reference to an invalid code point"
+		}
+
+		return string(r), end + 1, ""
+	}
+
+	if reference == "" || !isASCIIAlphaNumeric(reference) {
+		return "", 0, "malformed named character reference"
+	}
+
+	entity := str[:end+1]
+	decoded := html.UnescapeString(entity)
+	// UnescapeString also expands a known prefix of an unknown name (&notit;
+	// becomes ¬it;), so anything left over marks an unknown reference.
+	if decoded == entity || strings.HasSuffix(decoded, ";") && reference != "semi" {
+		return "", 0, fmt.Sprintf("unknown named character reference &%s;", reference)
+	}
+
+	return decoded, end + 1, ""
+}
+
+func isHex(c byte) bool {
+	return c >= '0' && c <= '9' || c|0x20 >= 'a' && c|0x20 <= 'f'
+}
+
+func isHexString(str string) bool {
+	for i := 0; i < len(str); i++ {
+		if !isHex(str[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func unhex(c byte) byte {
+	if c <= '9' {
+		return c - '0'
+	}
+
+	return (c | 0x20) - 'a' + 10
+}
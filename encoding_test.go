@@ -0,0 +1,140 @@
+package validator_test
+
+import (
+	validator "github.com/MrWormHole/simple-validator"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestIsPercentEncoded(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []testCase{
+		{"", true},
+		{"abc", true},
+		{"a%20b", true},
+		{"%E2%82%AC", true},
+		{"%e2%82%ac", true},
+		{"a+b=c&d", true},
+		{"100%", false},
+		{"%2", false},
+		{"%zz", false},
+		{"a b", false},
+		{"a%20b%", false},
+		{"<script>", false},
+		{"€", false},
+		{"a\nb", false},
+	}
+
+	for _, t := range testCases {
+		actual := validator.IsPercentEncoded(t.param)
+		assert.Equal(t.expected, actual, t.param)
+	}
+}
+
+func TestDecodePercent(t *testing.T) {
+	assert := assert.New(t)
+
+	decoded, err := validator.DecodePercent("%E2%82%AC+1%2F2")
+	assert.NoError(err)
+	assert.Equal("€+1/2", decoded)
+
+	_, err = validator.DecodePercent("abc%2")
+	var syntaxErr *validator.SyntaxError
+	assert.ErrorAs(err, &syntaxErr)
+	assert.Equal(int64(3), syntaxErr.Offset)
+	assert.EqualError(err, "syntax error at offset 3: % not followed by two hexadecimal digits")
+
+	_, err = validator.DecodePercent("a%20 b")
+	assert.ErrorAs(err, &syntaxErr)
+	assert.Equal(int64(4), syntaxErr.Offset)
+}
+
+func TestIsDoublePercentEncoded(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []testCase{
+		{"%253C", true},
+		{"a%2520b", true},
+		{"%25 %252f", true},
+		{"%3C", false},
+		{"100%25", false},
+		{"%25zz", false},
+		{"", false},
+	}
+
+	for _, t := range testCases {
+		actual := validator.IsDoublePercentEncoded(t.param)
+		assert.Equal(t.expected, actual, t.param)
+	}
+}
+
+func TestIsHTMLEntityEncoded(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []testCase{
+		{"", true},
+		{"plain text", true},
+		{"&lt;b&gt;", true},
+		{"Fish &amp; Chips", true},
+		{"&eacute;&nbsp;&hellip;", true},
+		{"&CounterClockwiseContourIntegral;", true},
+		{"&notin;", true},
+		{"&semi;", true},
+		{"&#60;&#x3C;&#X3c;&#128512;", true},
+		{"Fish & Chips", false},
+		{"&lt", false},
+		{"&amp", false},
+		{"&notit;", false},
+		{"&bogus;", false},
+		{"&;", false},
+		{"&#;", false},
+		{"&#x;", false},
+		{"&#0;", false},
+		{"&#xD800;", false},
+		{"&#x110000;", false},
+		{"&#99999999999;", false},
+		{"<b>", false},
+		{"a > b", false},
+	}
+
+	for _, t := range testCases {
+		actual := validator.IsHTMLEntityEncoded(t.param)
+		assert.Equal(t.expected, actual, t.param)
+	}
+}
+
+func TestDecodeHTMLEntities(t *testing.T) {
+	assert := assert.New(t)
+
+	decoded, err := validator.DecodeHTMLEntities("&lt;a href=&quot;x&quot;&gt; &eacute;&#x20AC;")
+	assert.NoError(err)
+	assert.Equal(`<a href="x"> é€`, decoded)
+
+	_, err = validator.DecodeHTMLEntities("ok &amp; &copy")
+	var syntaxErr *validator.SyntaxError
+	assert.ErrorAs(err, &syntaxErr)
+	assert.Equal(int64(9), syntaxErr.Offset)
+
+	_, err = validator.DecodeHTMLEntities("&foo;")
+	assert.EqualError(err, "syntax error at offset 0: unknown named character reference &foo;")
+}
+
+func TestIsDoubleHTMLEncoded(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []testCase{
+		{"&amp;lt;script&amp;gt;", true},
+		{"&amp;#60;", true},
+		{"x &amp; y &amp;quot;", true},
+		{"&amp;", false},
+		{"&lt;", false},
+		{"&amp;bogus;", false},
+		{"Fish &amp; Chips; more", false},
+	}
+
+	for _, t := range testCases {
+		actual := validator.IsDoubleHTMLEncoded(t.param)
+		assert.Equal(t.expected, actual, t.param)
+	}
+}
@@ -0,0 +1,416 @@
+package validator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// LatLngFormat configures how IsLatLng splits a coordinate pair. The zero value
+// expects "lat,lng".
+type LatLngFormat struct {
+	Separator string
+	LngFirst  bool
+}
+
+// IsLatLng reports whether str is a coordinate pair such as "51.5,-0.12".
+// Whitespace around each coordinate is ignored.
+func IsLatLng(str string, format LatLngFormat) bool {
+	_, _, ok := ParseLatLng(str, format)
+	return ok
+}
+
+// ParseLatLng parses a coordinate pair in the given format and returns the
+// latitude and longitude.
+func ParseLatLng(str string, format LatLngFormat) (float64, float64, bool) {
+	separator := format.Separator
+	if separator == "" {
+		separator = ","
+	}
+
+	parts := strings.Split(str, separator)
+	if len(parts) != 2 {
+		return 0, 0, false
+	}
+
+	lat, lng := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+	if format.LngFirst {
+		lat, lng = lng, lat
+	}
+	if !IsLatitude(lat) || !IsLongitude(lng) {
+		return 0, 0, false
+	}
+
+	latitude, err := strconv.ParseFloat(lat, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	longitude, err := strconv.ParseFloat(lng, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	return latitude, longitude, true
+}
+
+var ErrInvalidDMS = errors.New("invalid degree-minute-second coordinate")
+
+func IsDMS(str string) bool {
+	_, err := ParseDMS(str)
+	return err == nil
+}
+
+// ParseDMS converts a degree-minute-second coordinate such as 40°26'46"N,
+// 40° 26′ 46.3″ N or -73°59'8" to decimal degrees. Minutes and seconds are
+// optional. N/E are positive and S/W negative; a hemisphere letter may not be
+// combined with a sign. Latitudes (N/S) are limited to 90 degrees, all others
+// to 180.
+func ParseDMS(str string) (float64, error) {
+	value := strings.TrimSpace(str)
+	if value == "" {
+		return 0, ErrInvalidDMS
+	}
+
+	negative, latitude, hemisphere := false, false, false
+	switch value[len(value)-1] {
+	case 'N', 'n', 'E', 'e', 'S', 's', 'W', 'w':
+		h := value[len(value)-1] | 0x20
+		negative, latitude, hemisphere = h == 's' || h == 'w', h == 'n' || h == 's', true
+		value = strings.TrimSpace(value[:len(value)-1])
+	}
+	if !hemisphere && value != "" {
+		switch value[0] {
+		case 'N', 'n', 'E', 'e', 'S', 's', 'W', 'w':
+			h := value[0] | 0x20
+			negative, latitude, hemisphere = h == 's' || h == 'w', h == 'n' || h == 's', true
+			value = strings.TrimSpace(value[1:])
+		}
+	}
+	if value != "" && (value[0] == '-' || value[0] == '+') {
+		if hemisphere {
+			return 0, ErrInvalidDMS
+		}
+		negative = value[0] == '-'
+		value = value[1:]
+	}
+
+	units := []string{"°", "'′’", "\"″”"}
+	var components []float64
+	for i := 0; i < len(units) && value != ""; i++ {
+		value = strings.TrimSpace(value)
+		end := strings.IndexFunc(value, func(r rune) bool { return (r < '0' || r > '9') && r != '.' })
+		if end == -1 {
+			end = len(value)
+		}
+		number, rest := value[:end], value[end:]
+
+		unit := ""
+		for _, symbol := range units[i] {
+			if strings.HasPrefix(rest, string(symbol)) {
+				unit = string(symbol)
+				break
+			}
+		}
+		// Only plain decimal degrees may omit the unit symbol.
+		if unit == "" && (i != 0 || rest != "") {
+			return 0, ErrInvalidDMS
+		}
+
+		n, err := strconv.ParseFloat(number, 64)
+		if err != nil || number[0] == '.' {
+			return 0, ErrInvalidDMS
+		}
+		if i > 0 && n >= 60 {
+			return 0, ErrInvalidDMS
+		}
+		// Only the last component may carry a fraction.
+		if i > 0 && components[i-1] != math.Trunc(components[i-1]) {
+			return 0, ErrInvalidDMS
+		}
+		components = append(components, n)
+		value = strings.TrimSpace(rest[len(unit):])
+	}
+	if len(components) == 0 || strings.TrimSpace(value) != "" {
+		return 0, ErrInvalidDMS
+	}
+
+	degrees := components[0]
+	if len(components) > 1 {
+		degrees += components[1] / 60
+	}
+	if len(components) > 2 {
+		degrees += components[2] / 3600
+	}
+
+	limit := 180.0
+	if latitude {
+		limit = 90
+	}
+	if degrees > limit {
+		return 0, ErrInvalidDMS
+	}
+	if negative {
+		degrees = -degrees
+	}
+
+	return degrees, nil
+}
+
+const geohashAlphabet = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// IsGeohash reports whether str is a geohash whose length (precision) lies
+// within [minPrecision, maxPrecision]. A maxPrecision of 0 means 12.
+func IsGeohash(str string, minPrecision int, maxPrecision int) bool {
+	if maxPrecision <= 0 {
+		maxPrecision = 12
+	}
+	if minPrecision < 1 {
+		minPrecision = 1
+	}
+	if len(str) < minPrecision || len(str) > maxPrecision {
+		return false
+	}
+
+	for i := 0; i < len(str); i++ {
+		if strings.IndexByte(geohashAlphabet, str[i]) == -1 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// BoundingBox is an area delimited by its south-west and north-east corners.
+// A box whose MinLng is greater than its MaxLng crosses the antimeridian.
+type BoundingBox struct {
+	MinLat, MinLng float64
+	MaxLat, MaxLng float64
+}
+
+func IsBoundingBox(str string) bool {
+	_, ok := ParseBoundingBox(str)
+	return ok
+}
+
+// ParseBoundingBox parses a bounding box in the GeoJSON / OGC order
+// "minLng,minLat,maxLng,maxLat".
+func ParseBoundingBox(str string) (BoundingBox, bool) {
+	parts := strings.Split(str, ",")
+	if len(parts) != 4 {
+		return BoundingBox{}, false
+	}
+
+	values := make([]float64, 4)
+	for i, part := range parts {
+		part = strings.TrimSpace(part)
+		if i%2 == 0 && !IsLongitude(part) || i%2 == 1 && !IsLatitude(part) {
+			return BoundingBox{}, false
+		}
+		value, err := strconv.ParseFloat(part, 64)
+		if err != nil {
+			return BoundingBox{}, false
+		}
+		values[i] = value
+	}
+
+	box := BoundingBox{MinLng: values[0], MinLat: values[1], MaxLng: values[2], MaxLat: values[3]}
+
+	return box, box.MinLat <= box.MaxLat
+}
+
+// Contains reports whether the point lies inside the box, edges included.
+func (b BoundingBox) Contains(lat float64, lng float64) bool {
+	if lat < b.MinLat || lat > b.MaxLat {
+		return false
+	}
+	if b.MinLng <= b.MaxLng {
+		return lng >= b.MinLng && lng <= b.MaxLng
+	}
+
+	return lng >= b.MinLng || lng <= b.MaxLng
+}
+
+// GeoJSONError describes why a GeoJSON document is invalid. Path is a JSON
+// pointer to the offending member.
+type GeoJSONError struct {
+	Path   string
+	Reason string
+}
+
+func (e *GeoJSONError) Error() string {
+	return fmt.Sprintf("geojson: %s: %s", e.Path, e.Reason)
+}
+
+func IsGeoJSON(data []byte) bool {
+	return ValidateGeoJSON(data) == nil
+}
+
+// ValidateGeoJSON checks an RFC 7946 GeoJSON document: object types, geometry
+// types and coordinate nesting, minimum positions per LineString and ring, ring
+// closure, and that every position holds a valid longitude and latitude.
+func ValidateGeoJSON(data []byte) error {
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err != nil {
+		return &GeoJSONError{Path: "", Reason: "not a JSON object"}
+	}
+
+	return validateGeoJSONObject(object, "")
+}
+
+func validateGeoJSONObject(object map[string]json.RawMessage, path string) error {
+	var kind string
+	if err := json.Unmarshal(object["type"], &kind); err != nil {
+		return &GeoJSONError{Path: path + "/type", Reason: "missing or invalid type"}
+	}
+
+	switch kind {
+	case "FeatureCollection":
+		var features []map[string]json.RawMessage
+		if err := json.Unmarshal(object["features"], &features); err != nil || features == nil {
+			return &GeoJSONError{Path: path + "/features", Reason: "expected an array of features"}
+		}
+		for i, feature := range features {
+			featurePath := fmt.Sprintf("%s/features/%d", path, i)
+			var featureKind string
+			if json.Unmarshal(feature["type"], &featureKind) != nil || featureKind != "Feature" {
+				return &GeoJSONError{Path: featurePath + "/type", Reason: "expected Feature"}
+			}
+			if err := validateGeoJSONObject(feature, featurePath); err != nil {
+				return err
+			}
+		}
+	case "Feature":
+		geometry, ok := object["geometry"]
+		if !ok {
+			return &GeoJSONError{Path: path + "/geometry", Reason: "missing geometry"}
+		}
+		if string(geometry) == "null" {
+			return nil
+		}
+		var inner map[string]json.RawMessage
+		if err := json.Unmarshal(geometry, &inner); err != nil {
+			return &GeoJSONError{Path: path + "/geometry", Reason: "expected a geometry object"}
+		}
+		return validateGeoJSONGeometry(inner, path+"/geometry")
+	default:
+		return validateGeoJSONGeometry(object, path)
+	}
+
+	return nil
+}
+
+func validateGeoJSONGeometry(object map[string]json.RawMessage, path string) error {
+	var kind string
+	if err := json.Unmarshal(object["type"], &kind); err != nil {
+		return &GeoJSONError{Path: path + "/type", Reason: "missing or invalid type"}
+	}
+
+	if kind == "GeometryCollection" {
+		var geometries []map[string]json.RawMessage
+		if err := json.Unmarshal(object["geometries"], &geometries); err != nil || geometries == nil {
+			return &GeoJSONError{Path: path + "/geometries", Reason: "expected an array of geometries"}
+		}
+		for i, geometry := range geometries {
+			if err := validateGeoJSONGeometry(geometry, fmt.Sprintf("%s/geometries/%d", path, i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	depth, ok := map[string]int{
+		"Point": 0, "MultiPoint": 1, "LineString": 1,
+		"MultiLineString": 2, "Polygon": 2, "MultiPolygon": 3,
+	}[kind]
+	if !ok {
+		return &GeoJSONError{Path: path + "/type", Reason: fmt.Sprintf("unknown geometry type %q", kind)}
+	}
+
+	coordinatesPath := path + "/coordinates"
+	var coordinates interface{}
+	if err := json.Unmarshal(object["coordinates"], &coordinates); err != nil {
+		return &GeoJSONError{Path: coordinatesPath, Reason: "missing or invalid coordinates"}
+	}
+
+	return validateGeoJSONCoordinates(kind, coordinates, depth, coordinatesPath)
+}
+
+func validateGeoJSONCoordinates(kind string, coordinates interface{}, depth int, path string) error {
+	if depth == 0 {
+		return validateGeoJSONPosition(coordinates, path)
+	}
+
+	items, ok := coordinates.([]interface{})
+	if !ok {
+		return &GeoJSONError{Path: path, Reason: "expected an array"}
+	}
+
+	isRing := (kind == "Polygon" || kind == "MultiPolygon") && depth == 1
+	switch {
+	case (kind == "LineString" || kind == "MultiLineString" && depth == 1) && len(items) < 2:
+		return &GeoJSONError{Path: path, Reason: "a LineString needs at least two positions"}
+	case isRing && len(items) < 4:
+		return &GeoJSONError{Path: path, Reason: "a linear ring needs at least four positions"}
+	}
+
+	for i, item := range items {
+		if err := validateGeoJSONCoordinates(kind, item, depth-1, fmt.Sprintf("%s/%d", path, i)); err != nil {
+			return err
+		}
+	}
+
+	// The positions of a ring are valid by now, so they can be compared.
+	if isRing && !sameGeoJSONPosition(items[0], items[len(items)-1]) {
+		return &GeoJSONError{Path: path, Reason: "linear ring is not closed"}
+	}
+
+	return nil
+}
+
+func validateGeoJSONPosition(position interface{}, path string) error {
+	values, ok := position.([]interface{})
+	if !ok || len(values) < 2 || len(values) > 3 {
+		return &GeoJSONError{Path: path, Reason: "a position needs two or three numbers"}
+	}
+
+	for _, value := range values {
+		if _, ok := value.(float64); !ok {
+			return &GeoJSONError{Path: path, Reason: "a position needs two or three numbers"}
+		}
+	}
+
+	if !IsLongitude(strconv.FormatFloat(values[0].(float64), 'f', -1, 64)) {
+		return &GeoJSONError{Path: path + "/0", Reason: "longitude out of range"}
+	}
+	if !IsLatitude(strconv.FormatFloat(values[1].(float64), 'f', -1, 64)) {
+		return &GeoJSONError{Path: path + "/1", Reason: "latitude out of range"}
+	}
+
+	return nil
+}
+
+func sameGeoJSONPosition(a interface{}, b interface{}) bool {
+	first, ok := a.([]interface{})
+	if !ok {
+		return false
+	}
+	last, ok := b.([]interface{})
+	if !ok || len(first) != len(last) {
+		return false
+	}
+
+	for i := range first {
+		x, ok := first[i].(float64)
+		if !ok {
+			return false
+		}
+		if y, ok := last[i].(float64); !ok || x != y {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,175 @@
+package validator_test
+
+import (
+	validator "github.com/MrWormHole/simple-validator"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestIsLatLng(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []struct {
+		param    string
+		format   validator.LatLngFormat
+		expected bool
+	}{
+		{"51.5074,-0.1278", validator.LatLngFormat{}, true},
+		{"51.5074, -0.1278", validator.LatLngFormat{}, true},
+		{"-90,180", validator.LatLngFormat{}, true},
+		{"91,0", validator.LatLngFormat{}, false},
+		{"0,181", validator.LatLngFormat{}, false},
+		{"-0.1278,51.5074", validator.LatLngFormat{LngFirst: true}, true},
+		{"120,45", validator.LatLngFormat{}, false},
+		{"120,45", validator.LatLngFormat{LngFirst: true}, true},
+		{"51.5074 -0.1278", validator.LatLngFormat{Separator: " "}, true},
+		{"51.5074;-0.1278", validator.LatLngFormat{Separator: ";"}, true},
+		{"51.5074", validator.LatLngFormat{}, false},
+		{"1,2,3", validator.LatLngFormat{}, false},
+		{"", validator.LatLngFormat{}, false},
+	}
+
+	for _, t := range testCases {
+		actual := validator.IsLatLng(t.param, t.format)
+		assert.Equal(t.expected, actual, t.param)
+	}
+
+	lat, lng, ok := validator.ParseLatLng("-0.5,10", validator.LatLngFormat{LngFirst: true})
+	assert.True(ok)
+	assert.Equal(10.0, lat)
+	assert.Equal(-0.5, lng)
+}
+
+func TestParseDMS(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []struct {
+		param    string
+		expected float64
+		valid    bool
+	}{
+		{`40°26'46"N`, 40.44611111111111, true},
+		{`40° 26′ 46″ N`, 40.44611111111111, true},
+		{`79°58'56"W`, -79.98222222222222, true},
+		{`S 33°51'`, -33.85, true},
+		{`-73°59'8.5"`, -73.98569444444445, true},
+		{`12.5°`, 12.5, true},
+		{`12.5`, 12.5, true},
+		{`12.5N`, 12.5, true},
+		{`180°W`, -180, true},
+		{`91°N`, 0, false},
+		{`181°E`, 0, false},
+		{`40°60'N`, 0, false},
+		{`40°26'60"N`, 0, false},
+		{`40.5°26'N`, 0, false},
+		{`-40°N`, 0, false},
+		{`40'N`, 0, false},
+		{`40°26'46"X`, 0, false},
+		{`N`, 0, false},
+		{``, 0, false},
+	}
+
+	for _, t := range testCases {
+		actual, err := validator.ParseDMS(t.param)
+		if t.valid {
+			assert.NoError(err, t.param)
+			assert.InDelta(t.expected, actual, 1e-9, t.param)
+		} else {
+			assert.ErrorIs(err, validator.ErrInvalidDMS, t.param)
+		}
+		assert.Equal(t.valid, validator.IsDMS(t.param), t.param)
+	}
+}
+
+func TestIsGeohash(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []struct {
+		param    string
+		min      int
+		max      int
+		expected bool
+	}{
+		{"u4pruydqqvj", 0, 0, true},
+		{"gcpvj0", 5, 7, true},
+		{"gcpv", 5, 7, false},
+		{"gcpvj0duq", 5, 7, false},
+		{"gcpvj0duq", 0, 0, true},
+		{"u4pruydqqvjxyz", 0, 0, false},
+		{"u4pa", 0, 0, false},
+		{"U4PR", 0, 0, false},
+		{"", 0, 0, false},
+	}
+
+	for _, t := range testCases {
+		actual := validator.IsGeohash(t.param, t.min, t.max)
+		assert.Equal(t.expected, actual, t.param)
+	}
+}
+
+func TestBoundingBox(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.True(validator.IsBoundingBox("-0.5,51.2,0.3,51.7"))
+	assert.True(validator.IsBoundingBox("170,-10,-170,10"))
+	assert.False(validator.IsBoundingBox("-0.5,51.7,0.3,51.2"))
+	assert.False(validator.IsBoundingBox("-0.5,91,0.3,51.2"))
+	assert.False(validator.IsBoundingBox("1,2,3"))
+
+	box, ok := validator.ParseBoundingBox("-0.5,51.2,0.3,51.7")
+	assert.True(ok)
+	assert.True(box.Contains(51.5, -0.1))
+	assert.True(box.Contains(51.2, -0.5))
+	assert.False(box.Contains(52, -0.1))
+	assert.False(box.Contains(51.5, 1))
+
+	antimeridian, ok := validator.ParseBoundingBox("170,-10,-170,10")
+	assert.True(ok)
+	assert.True(antimeridian.Contains(0, 175))
+	assert.True(antimeridian.Contains(0, -175))
+	assert.False(antimeridian.Contains(0, 0))
+}
+
+func TestValidateGeoJSON(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []struct {
+		param    string
+		expected bool
+	}{
+		{`{"type":"Point","coordinates":[-0.1278,51.5074]}`, true},
+		{`{"type":"Point","coordinates":[-0.1278,51.5074,12]}`, true},
+		{`{"type":"LineString","coordinates":[[0,0],[1,1]]}`, true},
+		{`{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,0]]]}`, true},
+		{`{"type":"MultiPolygon","coordinates":[[[[0,0],[1,0],[1,1],[0,0]]]]}`, true},
+		{`{"type":"GeometryCollection","geometries":[{"type":"Point","coordinates":[0,0]}]}`, true},
+		{`{"type":"Feature","geometry":null,"properties":{}}`, true},
+		{`{"type":"FeatureCollection","features":[{"type":"Feature","geometry":{"type":"Point","coordinates":[1,2]},"properties":null}]}`, true},
+		{`{"type":"Point","coordinates":[51.5074,-181]}`, false},
+		{`{"type":"Point","coordinates":[0,91]}`, false},
+		{`{"type":"Point","coordinates":[0]}`, false},
+		{`{"type":"Point","coordinates":["0","0"]}`, false},
+		{`{"type":"LineString","coordinates":[[0,0]]}`, false},
+		{`{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,1]]]}`, false},
+		{`{"type":"Polygon","coordinates":[[[0,0],[1,0],[0,0]]]}`, false},
+		{`{"type":"Polygon","coordinates":[[[[1,2]],[[1,2]],[[1,2]],[[1,2]]]]}`, false},
+		{`{"type":"MultiPolygon","coordinates":[[[[[0,0]],[1,0],[1,1],[[0,0]]]]]}`, false},
+		{`{"type":"Circle","coordinates":[0,0]}`, false},
+		{`{"type":"Feature","properties":{}}`, false},
+		{`{"type":"FeatureCollection","features":[{"type":"Point","coordinates":[0,0]}]}`, false},
+		{`{"coordinates":[0,0]}`, false},
+		{`[]`, false},
+		{``, false},
+	}
+
+	for _, t := range testCases {
+		actual := validator.IsGeoJSON([]byte(t.param))
+		assert.Equal(t.expected, actual, t.param)
+	}
+
+	err := validator.ValidateGeoJSON([]byte(`{"type":"FeatureCollection","features":[{"type":"Feature","geometry":{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,1]]]}}]}`))
+	var geoErr *validator.GeoJSONError
+	assert.ErrorAs(err, &geoErr)
+	assert.Equal("/features/0/geometry/coordinates/0", geoErr.Path)
+	assert.Equal("linear ring is not closed", geoErr.Reason)
+}
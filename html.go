@@ -0,0 +1,261 @@
+package validator
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// HTMLTag is a start or self-closing tag found in an HTML fragment.
+type HTMLTag struct {
+	Name       string
+	Attributes []HTMLAttribute
+}
+
+// HTMLAttribute is an attribute with its entity-decoded value.
+type HTMLAttribute struct {
+	Name  string
+	Value string
+}
+
+// HTMLPolicy is an allow-list of markup. Tags maps each allowed tag name to the
+// attributes it may carry. URL-valued attributes (href, src, ...) must either be
+// relative or use one of URLSchemes. Event handler attributes (on*) and
+// javascript: or vbscript: URLs are always rejected.
+type HTMLPolicy struct {
+	Tags       map[string][]string
+	URLSchemes []string
+}
+
+// HTMLViolation describes markup that an HTMLPolicy does not allow.
+type HTMLViolation struct {
+	Tag       string
+	Attribute string
+	Reason    string
+}
+
+func (v HTMLViolation) String() string {
+	if v.Tag == "" {
+		return v.Reason
+	}
+	if v.Attribute == "" {
+		return fmt.Sprintf("<%s>: %s", v.Tag, v.Reason)
+	}
+
+	return fmt.Sprintf("<%s %s>: %s", v.Tag, v.Attribute, v.Reason)
+}
+
+var (
+	htmlURLAttributes = map[string]struct{}{
+		"href": {}, "src": {}, "action": {}, "formaction": {}, "cite": {}, "poster": {},
+		"background": {}, "longdesc": {}, "usemap": {}, "xlink:href": {}, "srcset": {},
+	}
+	htmlForbiddenSchemes = map[string]struct{}{
+		"javascript": {}, "vbscript": {},
+	}
+	// htmlRawTextTags are elements whose content is dropped together with the
+	// element when the policy does not allow them.
+	htmlRawTextTags = map[string]struct{}{
+		"script": {}, "style": {}, "iframe": {}, "noscript": {}, "noembed": {},
+		"noframes": {}, "xmp": {}, "textarea": {}, "title": {}, "template": {}, "object": {},
+	}
+)
+
+// IsHTML reports whether str contains at least one HTML start, end or
+// self-closing tag according to the HTML5 tokenizer, so that text such as
+// "a < b > c" is not mistaken for markup. Inputs longer than MaxInputLength
+// are rejected.
+func IsHTML(str string) bool {
+	if exceedsMaxInputLength(str) {
+		return false
+	}
+
+	tokenizer := html.NewTokenizer(strings.NewReader(str))
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			return false
+		case html.StartTagToken, html.EndTagToken, html.SelfClosingTagToken:
+			return true
+		}
+	}
+}
+
+// HTMLTags returns the start and self-closing tags found in str in document
+// order, or nil when str is longer than MaxInputLength.
+func HTMLTags(str string) []HTMLTag {
+	var tags []HTMLTag
+	if exceedsMaxInputLength(str) {
+		return nil
+	}
+
+	tokenizer := html.NewTokenizer(strings.NewReader(str))
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			return tags
+		case html.StartTagToken, html.SelfClosingTagToken:
+			token := tokenizer.Token()
+			tag := HTMLTag{Name: token.Data}
+			for _, attribute := range token.Attr {
+				tag.Attributes = append(tag.Attributes, HTMLAttribute{Name: attribute.Key, Value: attribute.Val})
+			}
+			tags = append(tags, tag)
+		}
+	}
+}
+
+// IsHTMLAllowed reports whether every tag and attribute in str is allowed by
+// the policy.
+func IsHTMLAllowed(str string, policy HTMLPolicy) bool {
+	return len(CheckHTML(str, policy)) == 0
+}
+
+// CheckHTML returns every policy violation in str. Comments and doctypes are
+// reported as violations as well, since they are never allowed. Inputs longer
+// than MaxInputLength are reported as a single violation without being parsed.
+func CheckHTML(str string, policy HTMLPolicy) []HTMLViolation {
+	var violations []HTMLViolation
+	if exceedsMaxInputLength(str) {
+		return []HTMLViolation{{Reason: ErrTooLong.Error()}}
+	}
+
+	tokenizer := html.NewTokenizer(strings.NewReader(str))
+	for {
+		tokenType := tokenizer.Next()
+		switch tokenType {
+		case html.ErrorToken:
+			return violations
+		case html.CommentToken:
+			violations = append(violations, HTMLViolation{Tag: "!--", Reason: "comments are not allowed"})
+		case html.DoctypeToken:
+			violations = append(violations, HTMLViolation{Tag: "!doctype", Reason: "doctypes are not allowed"})
+		case html.StartTagToken, html.SelfClosingTagToken, html.EndTagToken:
+			token := tokenizer.Token()
+			allowed, ok := policy.Tags[token.Data]
+			if !ok {
+				if tokenType != html.EndTagToken {
+					violations = append(violations, HTMLViolation{Tag: token.Data, Reason: "tag is not allowed"})
+				}
+				continue
+			}
+			for _, attribute := range token.Attr {
+				if reason := policy.checkAttribute(attribute, allowed); reason != "" {
+					violations = append(violations, HTMLViolation{Tag: token.Data, Attribute: attribute.Key, Reason: reason})
+				}
+			}
+		}
+	}
+}
+
+// SanitizeHTML rewrites str so that it only contains markup allowed by the
+// policy. Disallowed tags are removed but their text is kept, except for
+// elements such as script and style whose content is removed as well.
+// Disallowed attributes, comments and doctypes are dropped and all text is
+// re-escaped. Inputs longer than MaxInputLength are sanitized to the empty
+// string.
+func SanitizeHTML(str string, policy HTMLPolicy) string {
+	var b strings.Builder
+	if exceedsMaxInputLength(str) {
+		return ""
+	}
+
+	skipping := ""
+	tokenizer := html.NewTokenizer(strings.NewReader(str))
+	for {
+		tokenType := tokenizer.Next()
+		if tokenType == html.ErrorToken {
+			return b.String()
+		}
+
+		token := tokenizer.Token()
+		if skipping != "" {
+			if tokenType == html.EndTagToken && token.Data == skipping {
+				skipping = ""
+			}
+			continue
+		}
+
+		switch tokenType {
+		case html.TextToken:
+			b.WriteString(html.EscapeString(token.Data))
+		case html.StartTagToken, html.SelfClosingTagToken, html.EndTagToken:
+			allowed, ok := policy.Tags[token.Data]
+			if !ok {
+				if _, rawText := htmlRawTextTags[token.Data]; rawText && tokenType == html.StartTagToken {
+					skipping = token.Data
+				}
+				continue
+			}
+
+			var attributes []html.Attribute
+			for _, attribute := range token.Attr {
+				if policy.checkAttribute(attribute, allowed) == "" {
+					attributes = append(attributes, attribute)
+				}
+			}
+			token.Attr = attributes
+			b.WriteString(token.String())
+		}
+	}
+}
+
+func (p HTMLPolicy) checkAttribute(attribute html.Attribute, allowed []string) string {
+	name := attribute.Key
+	if attribute.Namespace != "" {
+		name = attribute.Namespace + ":" + name
+	}
+
+	if strings.HasPrefix(name, "on") {
+		return "event handler attributes are not allowed"
+	}
+
+	permitted := false
+	for _, candidate := range allowed {
+		if candidate == name {
+			permitted = true
+			break
+		}
+	}
+
+	if _, isURL := htmlURLAttributes[name]; isURL {
+		if reason := p.checkURL(attribute.Val); reason != "" {
+			return reason
+		}
+	}
+
+	if !permitted {
+		return "attribute is not allowed"
+	}
+
+	return ""
+}
+
+func (p HTMLPolicy) checkURL(value string) string {
+	// Browsers ignore ASCII whitespace and control characters inside the
+	// scheme, so "java\tscript:" must be treated as "javascript:".
+	url := strings.Map(func(r rune) rune {
+		if r <= ' ' || r == 0x7f {
+			return -1
+		}
+		return r
+	}, value)
+
+	end := strings.IndexAny(url, ":/?#")
+	if end == -1 || url[end] != ':' {
+		return ""
+	}
+
+	scheme := strings.ToLower(url[:end])
+	if _, forbidden := htmlForbiddenSchemes[scheme]; forbidden {
+		return fmt.Sprintf("%s: URLs are not allowed", scheme)
+	}
+	for _, allowed := range p.URLSchemes {
+		if strings.EqualFold(allowed, scheme) {
+			return ""
+		}
+	}
+
+	return fmt.Sprintf("URL scheme %q is not allowed", scheme)
+}
@@ -0,0 +1,112 @@
+package validator_test
+
+import (
+	validator "github.com/MrWormHole/simple-validator"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var basicHTMLPolicy = validator.HTMLPolicy{
+	Tags: map[string][]string{
+		"b": nil,
+		"i": nil,
+		"a": {"href", "title"},
+	},
+	URLSchemes: []string{"https"},
+}
+
+func TestIsHTMLTokenizer(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []testCase{
+		{"a < b > c", false},
+		{"1 <2 and 3> 2", false},
+		{"x<y", false},
+		{"<br/>", true},
+		{"hello <b>world</b>", true},
+		{"<!-- comment -->", false},
+		{"<!DOCTYPE html>", false},
+	}
+
+	for _, t := range testCases {
+		actual := validator.IsHTML(t.param)
+		assert.Equal(t.expected, actual, t.param)
+	}
+}
+
+func TestHTMLTags(t *testing.T) {
+	assert := assert.New(t)
+
+	tags := validator.HTMLTags(`<p class="x">hi <a href="/a" title='t'>link</a><br/></p>`)
+	assert.Equal([]validator.HTMLTag{
+		{Name: "p", Attributes: []validator.HTMLAttribute{{Name: "class", Value: "x"}}},
+		{Name: "a", Attributes: []validator.HTMLAttribute{{Name: "href", Value: "/a"}, {Name: "title", Value: "t"}}},
+		{Name: "br"},
+	}, tags)
+
+	assert.Empty(validator.HTMLTags("plain text"))
+}
+
+func TestCheckHTML(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []testCase{
+		{"plain text", true},
+		{"<b>bold</b> and <i>italic</i>", true},
+		{`<a href="https://example.com">x</a>`, true},
+		{`<a href="/relative">x</a>`, true},
+		{`<a href="#top" title="top">x</a>`, true},
+		{`<a href="http://example.com">x</a>`, false},
+		{`<a href="javascript:alert(1)">x</a>`, false},
+		{`<a href="JaVaScRiPt:alert(1)">x</a>`, false},
+		{`<a href="java&#x09;script:alert(1)">x</a>`, false},
+		{`<a href=" javascript:alert(1)">x</a>`, false},
+		{`<b onclick="alert(1)">x</b>`, false},
+		{`<a href="https://example.com" target="_blank">x</a>`, false},
+		{`<script>alert(1)</script>`, false},
+		{`<img src=x onerror=alert(1)>`, false},
+		{`<scr<script>ipt>alert(1)</script>`, false},
+		{`<!-- hidden -->`, false},
+	}
+
+	for _, t := range testCases {
+		actual := validator.IsHTMLAllowed(t.param, basicHTMLPolicy)
+		assert.Equal(t.expected, actual, t.param)
+	}
+
+	violations := validator.CheckHTML(`<a href="javascript:alert(1)" onmouseover="x()">x</a><u>y</u>`, basicHTMLPolicy)
+	assert.Equal([]validator.HTMLViolation{
+		{Tag: "a", Attribute: "href", Reason: "javascript: URLs are not allowed"},
+		{Tag: "a", Attribute: "onmouseover", Reason: "event handler attributes are not allowed"},
+		{Tag: "u", Reason: "tag is not allowed"},
+	}, violations)
+	assert.Equal("<a href>: javascript: URLs are not allowed", violations[0].String())
+	assert.Equal("<u>: tag is not allowed", violations[2].String())
+}
+
+func TestSanitizeHTML(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []struct {
+		param    string
+		expected string
+	}{
+		{"plain text", "plain text"},
+		{"a < b & c", "a &lt; b &amp; c"},
+		{"<b>bold</b>", "<b>bold</b>"},
+		{`<b onclick="alert(1)">bold</b>`, "<b>bold</b>"},
+		{`<u>under</u>line`, "underline"},
+		{`<a href="https://example.com" target="_blank">x</a>`, `<a href="https://example.com">x</a>`},
+		{`<a href="javascript:alert(1)">x</a>`, "<a>x</a>"},
+		{`hi<script>alert("x")</script>!`, "hi!"},
+		{`<style>body{}</style>text`, "text"},
+		{`<!-- c -->text`, "text"},
+		{`<img src=x onerror=alert(1)>`, ""},
+	}
+
+	for _, t := range testCases {
+		actual := validator.SanitizeHTML(t.param, basicHTMLPolicy)
+		assert.Equal(t.expected, actual, t.param)
+		assert.True(validator.IsHTMLAllowed(actual, basicHTMLPolicy), actual)
+	}
+}
@@ -0,0 +1,321 @@
+// Package httpvalidate validates the inputs of HTTP requests before they
+// reach their handlers, and reports invalid requests as RFC 7807 problem
+// details:
+//
+//	rules := httpvalidate.Rules{
+//		Path:   []httpvalidate.Param{{Name: "id", Rules: "required,uuid"}},
+//		Query:  []httpvalidate.Param{{Name: "limit", Rules: "omitempty,numeric"}},
+//		Header: []httpvalidate.Param{{Name: "X-Request-ID", Rules: "omitempty,uuid4"}},
+//		Body: []httpvalidate.Param{
+//			{Name: "/email", Rules: "required,email"},
+//			{Name: "/address/country", Rules: "required,iso3166_alpha2"},
+//		},
+//		PathValue: func(r *http.Request, name string) string {
+//			return router.Param(r, name) // the URL parameter function of the router
+//		},
+//	}
+//	router.Handle(http.MethodPut, "/users/{id}", httpvalidate.Middleware(rules)(handler))
+//
+// A request with invalid inputs gets a 400 Bad Request response with an
+// application/problem+json body listing every failing input:
+//
+//	{
+//		"type": "about:blank",
+//		"title": "Bad Request",
+//		"status": 400,
+//		"detail": "the request has 1 invalid parameter",
+//		"invalid-params": [
+//			{"name": "/email", "in": "body", "rule": "email", "reason": "must be a valid email"}
+//		]
+//	}
+//
+// Rules are validate tags as understood by validator.ValidateValue. Path,
+// query and header values, and JSON strings, numbers and booleans of the body,
+// are validated as strings: min, max and len count characters, and missing
+// inputs and JSON null are the empty string. JSON arrays and objects are
+// validated as such, so that min, max and len count their elements.
+package httpvalidate
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	validator "github.com/MrWormHole/simple-validator"
+)
+
+// DefaultMaxBodyBytes is the size limit of request bodies validated when
+// Rules.MaxBodyBytes is zero.
+const DefaultMaxBodyBytes = 1 << 20
+
+// Param is an input of a request and the rules it must satisfy, written as a
+// validate tag such as "required,email". Inputs of the body are named by JSON
+// pointers such as /address/country.
+type Param struct {
+	Name  string
+	Rules string
+}
+
+// Rules lists the inputs of requests to validate, in the order they are
+// reported.
+type Rules struct {
+	Path   []Param
+	Query  []Param
+	Header []Param
+	Body   []Param
+
+	// PathValue returns the value of a path parameter, such as a wrapper of
+	// the URL parameter function of a router, or (*http.Request).PathValue
+	// from Go 1.22. It is required when Path is not empty.
+	PathValue func(r *http.Request, name string) string
+
+	// MaxBodyBytes is the size limit of the bodies read to validate Body, or
+	// DefaultMaxBodyBytes when zero. Larger bodies are rejected with 413
+	// Request Entity Too Large.
+	MaxBodyBytes int64
+}
+
+// Middleware returns a middleware that validates requests against rules and
+// responds to invalid ones with a Problem, without calling the next handler.
+// The body of a request is read to validate it and replaced by a copy for the
+// next handler. Middleware panics if rules cannot be applied, such as when a
+// rule is unknown, so that mistakes are found when the handlers are set up;
+// the requests it validates then only fail with a Problem.
+func Middleware(rules Rules) func(http.Handler) http.Handler {
+	if err := rules.check(); err != nil {
+		panic(err)
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if err := Validate(r, rules); err != nil {
+				var problem *Problem
+				if !errors.As(err, &problem) {
+					problem = newProblem(http.StatusInternalServerError, "")
+				}
+				WriteProblem(w, problem)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// Validate checks the inputs of r against rules. It returns a *Problem when
+// the request is invalid, with status 400 Bad Request, or 413 Request Entity
+// Too Large for a body larger than rules.MaxBodyBytes. A body that cannot be
+// read, such as when the client disconnects, is reported as 400 Bad Request
+// too. Other errors, such as a malformed rule, are returned as is. When
+// rules.Body is not empty, the body of r is read and replaced by a copy.
+func Validate(r *http.Request, rules Rules) error {
+	if len(rules.Path) > 0 && rules.PathValue == nil {
+		return errors.New("httpvalidate: path rules need Rules.PathValue")
+	}
+
+	v := &requestValidator{}
+	for _, param := range rules.Path {
+		if err := v.check("path", param, rules.PathValue(r, param.Name)); err != nil {
+			return err
+		}
+	}
+
+	if len(rules.Query) > 0 {
+		query := r.URL.Query()
+		for _, param := range rules.Query {
+			if err := v.check("query", param, query.Get(param.Name)); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, param := range rules.Header {
+		if err := v.check("header", param, r.Header.Get(param.Name)); err != nil {
+			return err
+		}
+	}
+
+	if len(rules.Body) > 0 {
+		body, problem := readBody(r, rules.MaxBodyBytes)
+		if problem != nil {
+			return problem
+		}
+		for _, param := range rules.Body {
+			value, _ := lookupPointer(body, param.Name)
+			if err := v.check("body", param, bodyValue(value)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(v.invalid) == 0 {
+		return nil
+	}
+
+	detail := "the request has 1 invalid parameter"
+	if len(v.invalid) > 1 {
+		detail = "the request has " + strconv.Itoa(len(v.invalid)) + " invalid parameters"
+	}
+	problem := newProblem(http.StatusBadRequest, detail)
+	problem.InvalidParams = v.invalid
+
+	return problem
+}
+
+// requestValidator collects the invalid inputs of a request.
+type requestValidator struct {
+	invalid []InvalidParam
+}
+
+func (v *requestValidator) check(in string, param Param, value any) error {
+	err := validator.ValidateValue(value, param.Rules)
+	if err == nil {
+		return nil
+	}
+
+	var fieldErr *validator.FieldError
+	var tagErr *validator.TagError
+	switch {
+	case errors.As(err, &fieldErr):
+		v.invalid = append(v.invalid, InvalidParam{Name: param.Name, In: in, Rule: fieldErr.Rule, Reason: fieldErr.Message})
+	case errors.As(err, &tagErr) && in == "body" && isJSONContainer(value):
+		// Rules for strings applied to a JSON array or object.
+		rule, _, _ := strings.Cut(tagErr.Tag, "=")
+		v.invalid = append(v.invalid, InvalidParam{Name: param.Name, In: in, Rule: rule, Reason: "must be a string"})
+	default:
+		return fmt.Errorf("httpvalidate: %s %s: %w", in, param.Name, err)
+	}
+
+	return nil
+}
+
+// check reports the first input of the rules that cannot be validated.
+func (rules Rules) check() error {
+	if len(rules.Path) > 0 && rules.PathValue == nil {
+		return errors.New("httpvalidate: path rules need Rules.PathValue")
+	}
+
+	inputs := []struct {
+		in     string
+		params []Param
+	}{{"path", rules.Path}, {"query", rules.Query}, {"header", rules.Header}, {"body", rules.Body}}
+	for _, input := range inputs {
+		in := input.in
+		for _, param := range input.params {
+			if in == "body" && param.Name != "" && param.Name[0] != '/' {
+				return fmt.Errorf("httpvalidate: body %s: expected a JSON pointer", param.Name)
+			}
+			if param.Rules == "" {
+				continue
+			}
+			// Each rule is applied on its own so that it is reached
+			// whatever the rules before it.
+			for _, rule := range strings.Split(param.Rules, ",") {
+				var tagErr *validator.TagError
+				if err := validator.ValidateValue("x", rule); errors.As(err, &tagErr) {
+					return fmt.Errorf("httpvalidate: %s %s: %w", in, param.Name, err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// readBody decodes the JSON body of r, replacing it with a copy. An empty body
+// decodes as nil.
+func readBody(r *http.Request, maxBytes int64) (any, *Problem) {
+	if r.Body == nil || r.Body == http.NoBody {
+		return nil, nil
+	}
+	if maxBytes <= 0 {
+		maxBytes = DefaultMaxBodyBytes
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r.Body, maxBytes+1))
+	r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewReader(data))
+	if err != nil {
+		return nil, newProblem(http.StatusBadRequest, "the request body cannot be read: "+err.Error())
+	}
+	if int64(len(data)) > maxBytes {
+		return nil, newProblem(http.StatusRequestEntityTooLarge, "the request body is larger than "+strconv.FormatInt(maxBytes, 10)+" bytes")
+	}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, nil
+	}
+
+	var body any
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&body); err != nil {
+		return nil, newProblem(http.StatusBadRequest, "the request body is not valid JSON: "+err.Error())
+	}
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return nil, newProblem(http.StatusBadRequest, "the request body is not valid JSON: unexpected data after the top-level value")
+	}
+
+	return body, nil
+}
+
+// lookupPointer resolves an RFC 6901 JSON pointer in a decoded JSON value.
+func lookupPointer(value any, pointer string) (any, bool) {
+	if pointer == "" {
+		return value, true
+	}
+	if pointer[0] != '/' {
+		return nil, false
+	}
+
+	for _, token := range strings.Split(pointer[1:], "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		switch container := value.(type) {
+		case map[string]any:
+			element, ok := container[token]
+			if !ok {
+				return nil, false
+			}
+			value = element
+		case []any:
+			index, err := strconv.Atoi(token)
+			if err != nil || index < 0 || index >= len(container) || (token != "0" && token[0] == '0') {
+				return nil, false
+			}
+			value = container[index]
+		default:
+			return nil, false
+		}
+	}
+
+	return value, true
+}
+
+// bodyValue returns the value a decoded JSON value is validated as.
+func bodyValue(value any) any {
+	switch value := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case json.Number:
+		return value.String()
+	case bool:
+		return strconv.FormatBool(value)
+	}
+
+	return value
+}
+
+func isJSONContainer(value any) bool {
+	switch value.(type) {
+	case map[string]any, []any:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,166 @@
+package httpvalidate_test
+
+import (
+	"encoding/json"
+	"errors"
+	validator "github.com/MrWormHole/simple-validator"
+	"github.com/MrWormHole/simple-validator/httpvalidate"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+var userRules = httpvalidate.Rules{
+	Path:   []httpvalidate.Param{{Name: "id", Rules: "required,uuid"}},
+	Query:  []httpvalidate.Param{{Name: "limit", Rules: "omitempty,numeric"}},
+	Header: []httpvalidate.Param{{Name: "X-Request-ID", Rules: "omitempty,uuid4"}},
+	Body: []httpvalidate.Param{
+		{Name: "/email", Rules: "required,email"},
+		{Name: "/address/country", Rules: "required,iso3166_alpha2"},
+		{Name: "/tags", Rules: "omitempty,max=2"},
+		{Name: "/tags/0", Rules: "omitempty,alpha"},
+		{Name: "/a~1b", Rules: "omitempty,numeric"},
+	},
+	PathValue: func(r *http.Request, name string) string {
+		return strings.TrimPrefix(r.URL.Path, "/users/")
+	},
+}
+
+func newRequest(target string, body string) *http.Request {
+	r := httptest.NewRequest(http.MethodPut, target, strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	return r
+}
+
+func serve(rules httpvalidate.Rules, r *http.Request) (*httptest.ResponseRecorder, string) {
+	var received string
+	handler := httpvalidate.Middleware(rules)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received = string(body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	return w, received
+}
+
+func decodeProblem(t *testing.T, w *httptest.ResponseRecorder) httpvalidate.Problem {
+	var problem httpvalidate.Problem
+	if err := json.Unmarshal(w.Body.Bytes(), &problem); err != nil {
+		t.Fatal(err)
+	}
+	return problem
+}
+
+func TestMiddleware(t *testing.T) {
+	assert := assert.New(t)
+
+	body := `{"email": "john.doe@example.com", "address": {"country": "DE"}, "tags": ["admin"], "a/b": 42}`
+	w, received := serve(userRules, newRequest("/users/6ba7b810-9dad-11d1-80b4-00c04fd430c8?limit=10", body))
+	assert.Equal(http.StatusNoContent, w.Code)
+	assert.Equal(body, received)
+
+	r := newRequest("/users/42?limit=ten", `{"email": "john.doe@", "address": {"country": "Germany"}, "tags": ["a", "b", "c"], "a/b": true}`)
+	r.Header.Set("X-Request-ID", "not-a-uuid")
+	w, received = serve(userRules, r)
+	assert.Equal("", received)
+	assert.Equal(http.StatusBadRequest, w.Code)
+	assert.Equal("application/problem+json", w.Header().Get("Content-Type"))
+	assert.Equal(httpvalidate.Problem{
+		Type:   "about:blank",
+		Title:  "Bad Request",
+		Status: http.StatusBadRequest,
+		Detail: "the request has 7 invalid parameters",
+		InvalidParams: []httpvalidate.InvalidParam{
+			{Name: "id", In: "path", Rule: "uuid", Reason: "must be a valid uuid"},
+			{Name: "limit", In: "query", Rule: "numeric", Reason: "must be a valid numeric"},
+			{Name: "X-Request-ID", In: "header", Rule: "uuid4", Reason: "must be a valid uuid4"},
+			{Name: "/email", In: "body", Rule: "email", Reason: "must be a valid email"},
+			{Name: "/address/country", In: "body", Rule: "iso3166_alpha2", Reason: "must be a valid iso3166_alpha2"},
+			{Name: "/tags", In: "body", Rule: "max", Reason: "must have at most 2 elements"},
+			{Name: "/a~1b", In: "body", Rule: "numeric", Reason: "must be a valid numeric"},
+		},
+	}, decodeProblem(t, w))
+}
+
+func TestMiddlewareBody(t *testing.T) {
+	assert := assert.New(t)
+
+	rules := httpvalidate.Rules{
+		Body:         []httpvalidate.Param{{Name: "/email", Rules: "required,email"}, {Name: "/name", Rules: "omitempty,alpha"}},
+		MaxBodyBytes: 64,
+	}
+
+	w, _ := serve(rules, newRequest("/", ""))
+	assert.Equal(http.StatusBadRequest, w.Code)
+	assert.Equal([]httpvalidate.InvalidParam{{Name: "/email", In: "body", Rule: "required", Reason: "is required"}}, decodeProblem(t, w).InvalidParams)
+
+	w, _ = serve(rules, newRequest("/", `{"email": null, "name": {"first": "John"}}`))
+	assert.Equal([]httpvalidate.InvalidParam{
+		{Name: "/email", In: "body", Rule: "required", Reason: "is required"},
+		{Name: "/name", In: "body", Rule: "alpha", Reason: "must be a string"},
+	}, decodeProblem(t, w).InvalidParams)
+
+	w, _ = serve(rules, newRequest("/", `{"email": "john.doe@example.com",`))
+	assert.Equal(http.StatusBadRequest, w.Code)
+	assert.Equal("the request body is not valid JSON: unexpected EOF", decodeProblem(t, w).Detail)
+
+	w, _ = serve(rules, newRequest("/", `{"email": "john.doe@example.com"} {}`))
+	assert.Equal(http.StatusBadRequest, w.Code)
+	assert.Empty(decodeProblem(t, w).InvalidParams)
+
+	w, _ = serve(rules, newRequest("/", `{"email": "john.doe@example.com", "name": "`+strings.Repeat("a", 64)+`"}`))
+	assert.Equal(http.StatusRequestEntityTooLarge, w.Code)
+	assert.Equal("the request body is larger than 64 bytes", decodeProblem(t, w).Detail)
+
+	// A client that disconnects while sending the body.
+	r := httptest.NewRequest(http.MethodPut, "/", iotest.ErrReader(io.ErrUnexpectedEOF))
+	w, received := serve(rules, r)
+	assert.Equal("", received)
+	assert.Equal(http.StatusBadRequest, w.Code)
+	assert.Equal("the request body cannot be read: unexpected EOF", decodeProblem(t, w).Detail)
+}
+
+func TestMiddlewarePanics(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Panics(func() {
+		httpvalidate.Middleware(httpvalidate.Rules{Query: []httpvalidate.Param{{Name: "email", Rules: "required,emial"}}})
+	})
+	assert.Panics(func() {
+		httpvalidate.Middleware(httpvalidate.Rules{Query: []httpvalidate.Param{{Name: "limit", Rules: "omitempty,max=ten"}}})
+	})
+	assert.Panics(func() {
+		httpvalidate.Middleware(httpvalidate.Rules{Body: []httpvalidate.Param{{Name: "email", Rules: "email"}}})
+	})
+	assert.Panics(func() {
+		httpvalidate.Middleware(httpvalidate.Rules{Path: []httpvalidate.Param{{Name: "id", Rules: "uuid"}}})
+	})
+}
+
+func TestValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	rules := httpvalidate.Rules{Query: []httpvalidate.Param{{Name: "email", Rules: "required,email"}}}
+
+	assert.NoError(httpvalidate.Validate(httptest.NewRequest(http.MethodGet, "/?email=john.doe@example.com", nil), rules))
+
+	err := httpvalidate.Validate(httptest.NewRequest(http.MethodGet, "/?email=john", nil), rules)
+	assert.ErrorIs(err, validator.ErrInvalid)
+	assert.EqualError(err, "httpvalidate: query email must be a valid email")
+
+	var problem *httpvalidate.Problem
+	assert.True(errors.As(err, &problem))
+	assert.Equal(http.StatusBadRequest, problem.Status)
+
+	rules.Query[0].Rules = "emial"
+	err = httpvalidate.Validate(httptest.NewRequest(http.MethodGet, "/?email=john", nil), rules)
+	var tagErr *validator.TagError
+	assert.True(errors.As(err, &tagErr))
+	assert.False(errors.As(err, &problem))
+}
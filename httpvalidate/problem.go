@@ -0,0 +1,70 @@
+package httpvalidate
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	validator "github.com/MrWormHole/simple-validator"
+)
+
+// ContentType is the media type of Problem responses.
+const ContentType = "application/problem+json"
+
+// Problem is an RFC 7807 problem details object. The problems reported by
+// Validate and Middleware use the type about:blank, so that the title is the
+// HTTP status text, and list the failing inputs in InvalidParams.
+type Problem struct {
+	Type          string         `json:"type"`
+	Title         string         `json:"title"`
+	Status        int            `json:"status"`
+	Detail        string         `json:"detail,omitempty"`
+	Instance      string         `json:"instance,omitempty"`
+	InvalidParams []InvalidParam `json:"invalid-params,omitempty"`
+}
+
+// InvalidParam describes an input of a request that failed a rule. In is
+// "path", "query", "header" or "body", and Name is a JSON pointer for inputs
+// of the body.
+type InvalidParam struct {
+	Name   string `json:"name"`
+	In     string `json:"in"`
+	Rule   string `json:"rule,omitempty"`
+	Reason string `json:"reason"`
+}
+
+func newProblem(status int, detail string) *Problem {
+	return &Problem{Type: "about:blank", Title: http.StatusText(status), Status: status, Detail: detail}
+}
+
+func (p *Problem) Error() string {
+	if len(p.InvalidParams) == 0 {
+		return "httpvalidate: " + strings.ToLower(p.Title) + ": " + p.Detail
+	}
+
+	messages := make([]string, len(p.InvalidParams))
+	for i, param := range p.InvalidParams {
+		messages[i] = param.In + " " + param.Name + " " + param.Reason
+	}
+	return "httpvalidate: " + strings.Join(messages, "; ")
+}
+
+// Is reports whether target is validator.ErrInvalid.
+func (p *Problem) Is(target error) bool {
+	return target == validator.ErrInvalid
+}
+
+// WriteProblem writes p as the response to a request, with the
+// application/problem+json content type and p.Status as status code.
+func WriteProblem(w http.ResponseWriter, p *Problem) {
+	body, err := json.Marshal(p)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", ContentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(p.Status)
+	w.Write(append(body, '\n'))
+}
@@ -0,0 +1,45 @@
+package httpvalidate_test
+
+import (
+	"github.com/MrWormHole/simple-validator/httpvalidate"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWriteProblem(t *testing.T) {
+	assert := assert.New(t)
+
+	w := httptest.NewRecorder()
+	httpvalidate.WriteProblem(w, &httpvalidate.Problem{
+		Type:          "https://example.com/problems/invalid-order",
+		Title:         "Invalid order",
+		Status:        http.StatusUnprocessableEntity,
+		Instance:      "/orders/42",
+		InvalidParams: []httpvalidate.InvalidParam{{Name: "/quantity", In: "body", Reason: "must be at least 1"}},
+	})
+
+	assert.Equal(http.StatusUnprocessableEntity, w.Code)
+	assert.Equal(httpvalidate.ContentType, w.Header().Get("Content-Type"))
+	assert.JSONEq(`{
+		"type": "https://example.com/problems/invalid-order",
+		"title": "Invalid order",
+		"status": 422,
+		"instance": "/orders/42",
+		"invalid-params": [{"name": "/quantity", "in": "body", "reason": "must be at least 1"}]
+	}`, w.Body.String())
+}
+
+func TestProblemError(t *testing.T) {
+	assert := assert.New(t)
+
+	problem := &httpvalidate.Problem{Title: "Request Entity Too Large", Status: http.StatusRequestEntityTooLarge, Detail: "the request body is larger than 64 bytes"}
+	assert.EqualError(problem, "httpvalidate: request entity too large: the request body is larger than 64 bytes")
+
+	problem = &httpvalidate.Problem{Title: "Bad Request", Status: http.StatusBadRequest, InvalidParams: []httpvalidate.InvalidParam{
+		{Name: "id", In: "path", Reason: "must be a valid uuid"},
+		{Name: "/email", In: "body", Reason: "is required"},
+	}}
+	assert.EqualError(problem, "httpvalidate: path id must be a valid uuid; body /email is required")
+}
@@ -0,0 +1,57 @@
+package validator
+
+import "strings"
+
+// ibanLengths maps the ISO 3166-1 alpha-2 codes of the countries in the
+// SWIFT IBAN registry to the length of their IBANs.
+var ibanLengths = map[string]int{
+	"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AZ": 28, "BA": 20, "BE": 16, "BG": 22,
+	"BH": 22, "BI": 27, "BR": 29, "BY": 28, "CH": 21, "CR": 22, "CY": 28, "CZ": 24,
+	"DE": 22, "DJ": 27, "DK": 18, "DO": 28, "EE": 20, "EG": 29, "ES": 24, "FI": 18,
+	"FK": 18, "FO": 18, "FR": 27, "GB": 22, "GE": 22, "GI": 23, "GL": 18, "GR": 27,
+	"GT": 28, "HR": 21, "HU": 28, "IE": 22, "IL": 23, "IQ": 23, "IS": 26, "IT": 27,
+	"JO": 30, "KW": 30, "KZ": 20, "LB": 28, "LC": 32, "LI": 21, "LT": 20, "LU": 20,
+	"LV": 21, "LY": 25, "MC": 27, "MD": 24, "ME": 22, "MK": 19, "MN": 20, "MR": 27,
+	"MT": 31, "MU": 30, "NI": 28, "NL": 18, "NO": 15, "OM": 23, "PK": 24, "PL": 28,
+	"PS": 29, "PT": 25, "QA": 29, "RO": 24, "RS": 22, "RU": 33, "SA": 24, "SC": 31,
+	"SD": 18, "SE": 24, "SI": 19, "SK": 24, "SM": 27, "SO": 23, "ST": 25, "SV": 28,
+	"TL": 23, "TN": 24, "TR": 26, "UA": 29, "VA": 22, "VG": 24, "XK": 20, "YE": 30,
+}
+
+// IsIBAN reports whether str is an International Bank Account Number with
+// the length registered for its country and valid ISO 7064 MOD 97-10 check
+// digits. Both the electronic format, DE89370400440532013000, and the print
+// format with a space after every four characters are accepted; letters must
+// be upper case.
+func IsIBAN(str string) bool {
+	if strings.Contains(str, " ") {
+		groups := strings.Split(str, " ")
+		for i, group := range groups {
+			if len(group) != 4 && (i < len(groups)-1 || group == "" || len(group) > 4) {
+				return false
+			}
+		}
+		str = strings.Join(groups, "")
+	}
+
+	if len(str) < 4 || ibanLengths[str[:2]] != len(str) || !isDigitByte(str[2]) || !isDigitByte(str[3]) {
+		return false
+	}
+
+	// Move the country code and check digits to the end and read letters as
+	// the numbers 10 to 35.
+	remainder := 0
+	for i := 0; i < len(str); i++ {
+		c := str[(i+4)%len(str)]
+		switch {
+		case isDigitByte(c):
+			remainder = (remainder*10 + int(c-'0')) % 97
+		case c >= 'A' && c <= 'Z':
+			remainder = (remainder*100 + int(c-'A') + 10) % 97
+		default:
+			return false
+		}
+	}
+
+	return remainder == 1
+}
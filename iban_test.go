@@ -0,0 +1,40 @@
+package validator_test
+
+import (
+	validator "github.com/MrWormHole/simple-validator"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestIsIBAN(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []testCase{
+		{"DE89370400440532013000", true},
+		{"DE89 3704 0044 0532 0130 00", true},
+		{"GB82WEST12345698765432", true},
+		{"FR1420041010050500013M02606", true},
+		{"NO9386011117947", true},
+		{"MT84MALT011000012345MTLCAST001S", true},
+		{"BE68539007547034", true},
+		{"DE88370400440532013000", false},
+		{"DE8937040044053201300", false},
+		{"DE893704004405320130000", false},
+		{"de89370400440532013000", false},
+		{"GB82west12345698765432", false},
+		{"XX89370400440532013000", false},
+		{"NO93 8601 1117 947", true},
+		{"DE89 3704 0044 0532 0130 0", false},
+		{"DE89  3704 0044 0532 0130 00", false},
+		{"DE8 93704 0044 0532 0130 00", false},
+		{"DE89 3704 0044 0532 0130 00 ", false},
+		{"DE89-3704-0044-0532-0130-00", false},
+		{"", false},
+		{"DE", false},
+	}
+
+	for _, t := range testCases {
+		actual := validator.IsIBAN(t.param)
+		assert.Equal(t.expected, actual, t.param)
+	}
+}
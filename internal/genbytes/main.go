@@ -0,0 +1,80 @@
+// Command genbytes writes bytes_gen.go, which declares a []byte variant of
+// every validator of the package taking a single string, such as IsEmailBytes
+// for IsEmail. It is run by go generate in the root directory of the module.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/fs"
+	"log"
+	"os"
+	"sort"
+	"strings"
+)
+
+const output = "bytes_gen.go"
+
+func main() {
+	code, err := generate(".")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(output, code, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generate returns the source of bytes_gen.go for the package in dir.
+func generate(dir string) ([]byte, error) {
+	packages, err := parser.ParseDir(token.NewFileSet(), dir, func(info fs.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go") && info.Name() != output
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var pkgName string
+	var names []string
+	for _, pkg := range packages {
+		pkgName = pkg.Name
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				if fn, ok := decl.(*ast.FuncDecl); ok && isValidator(fn) {
+					names = append(names, fn.Name.Name)
+				}
+			}
+		}
+	}
+	if len(packages) != 1 {
+		return nil, fmt.Errorf("expected one package in %s, found %d", dir, len(packages))
+	}
+	sort.Strings(names)
+
+	var code bytes.Buffer
+	fmt.Fprintf(&code, "// Code generated by genbytes; DO NOT EDIT.\n\npackage %s\n", pkgName)
+	for _, name := range names {
+		fmt.Fprintf(&code, "\n// %sBytes is %s for a byte slice, which is not copied.\n", name, name)
+		fmt.Fprintf(&code, "func %sBytes(b []byte) bool {\n\treturn %s(bytesToString(b))\n}\n", name, name)
+	}
+
+	return format.Source(code.Bytes())
+}
+
+// isValidator reports whether fn is an exported Is or Has function taking a
+// single string and returning a bool.
+func isValidator(fn *ast.FuncDecl) bool {
+	name := fn.Name.Name
+	if fn.Recv != nil || !ast.IsExported(name) || !strings.HasPrefix(name, "Is") && !strings.HasPrefix(name, "Has") {
+		return false
+	}
+
+	params, results := fn.Type.Params.List, fn.Type.Results
+	return len(params) == 1 && len(params[0].Names) == 1 && types.ExprString(params[0].Type) == "string" &&
+		results != nil && len(results.List) == 1 && types.ExprString(results.List[0].Type) == "bool"
+}
@@ -0,0 +1,21 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGenerateIsUpToDate(t *testing.T) {
+	assert := assert.New(t)
+
+	dir := filepath.Join("..", "..")
+	code, err := generate(dir)
+	assert.NoError(err)
+
+	expected, err := os.ReadFile(filepath.Join(dir, output))
+	assert.NoError(err)
+	assert.Equal(string(expected), string(code), "run go generate in the root directory")
+	assert.Contains(string(code), "func IsEmailBytes(b []byte) bool")
+}
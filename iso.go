@@ -0,0 +1,395 @@
+package validator
+
+import (
+	_ "embed"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+var (
+	//go:embed data/iso3166-1.txt
+	iso3166Data string
+	//go:embed data/iso4217.txt
+	iso4217Data string
+	//go:embed data/iso639.txt
+	iso639Data string
+	//go:embed data/iso15924.txt
+	iso15924Data string
+	//go:embed data/tzdata.txt
+	tzData string
+)
+
+// The ISO and IANA tables are parsed from the embedded data on first use.
+var (
+	iso3166Codes  = newLazyTable(parseISO3166)
+	iso4217Codes  = newLazyTable(parseISO4217)
+	iso639Codes   = newLazyTable(parseISO639)
+	iso15924Codes = newLazyTable(func() map[string]struct{} { return firstFields(iso15924Data) })
+	ianaTimezones = newLazyTable(func() map[string]struct{} { return firstFields(tzData) })
+	unM49Regions  = map[string]struct{}{
+		"001": {}, "002": {}, "005": {}, "009": {}, "011": {}, "013": {}, "014": {}, "015": {},
+		"017": {}, "018": {}, "019": {}, "021": {}, "029": {}, "030": {}, "034": {}, "035": {},
+		"039": {}, "053": {}, "054": {}, "057": {}, "061": {}, "142": {}, "143": {}, "145": {},
+		"150": {}, "151": {}, "154": {}, "155": {}, "202": {}, "419": {},
+	}
+)
+
+// noMinorUnits marks ISO 4217 entries such as precious metals for which the
+// standard defines no minor unit.
+const noMinorUnits = -1
+
+type iso3166Table struct {
+	alpha2, alpha3, numeric map[string]struct{}
+}
+
+func parseISO3166() iso3166Table {
+	codes := iso3166Table{map[string]struct{}{}, map[string]struct{}{}, map[string]struct{}{}}
+	eachRecord(iso3166Data, func(fields []string) {
+		codes.alpha2[fields[0]] = struct{}{}
+		codes.alpha3[fields[1]] = struct{}{}
+		codes.numeric[fields[2]] = struct{}{}
+	})
+
+	return codes
+}
+
+type iso4217Table struct {
+	minorUnits map[string]int
+	numeric    map[string]struct{}
+}
+
+func parseISO4217() iso4217Table {
+	codes := iso4217Table{map[string]int{}, map[string]struct{}{}}
+	eachRecord(iso4217Data, func(fields []string) {
+		minor, err := strconv.Atoi(fields[2])
+		if err != nil {
+			minor = noMinorUnits
+		}
+		codes.minorUnits[fields[0]] = minor
+		codes.numeric[fields[1]] = struct{}{}
+	})
+
+	return codes
+}
+
+type iso639Table struct {
+	alpha2, alpha3 map[string]struct{}
+}
+
+func parseISO639() iso639Table {
+	codes := iso639Table{map[string]struct{}{}, map[string]struct{}{}}
+	eachRecord(iso639Data, func(fields []string) {
+		codes.alpha3[fields[0]] = struct{}{}
+		if fields[1] != "-" {
+			codes.alpha2[fields[1]] = struct{}{}
+		}
+		if fields[2] != "-" {
+			codes.alpha3[fields[2]] = struct{}{}
+		}
+	})
+
+	return codes
+}
+
+func eachRecord(data string, fn func(fields []string)) {
+	for _, line := range strings.Split(data, "\n") {
+		if fields := strings.Fields(line); len(fields) > 0 {
+			fn(fields)
+		}
+	}
+}
+
+// firstFields returns the set of the first fields of the records of data.
+func firstFields(data string) map[string]struct{} {
+	set := map[string]struct{}{}
+	eachRecord(data, func(fields []string) {
+		set[fields[0]] = struct{}{}
+	})
+
+	return set
+}
+
+// lazyTables holds every table created by newLazyTable so that Warmup can
+// load them all.
+var lazyTables []interface {
+	load()
+	isLoaded() bool
+}
+
+// lazyTable is a lookup table built on first use, so that importing the
+// package does not pay for parsing data that is never used.
+type lazyTable[T any] struct {
+	build  func() T
+	once   sync.Once
+	table  T
+	loaded int32 // set atomically once table is built
+}
+
+func newLazyTable[T any](build func() T) *lazyTable[T] {
+	t := &lazyTable[T]{build: build}
+	lazyTables = append(lazyTables, t)
+
+	return t
+}
+
+func (t *lazyTable[T]) get() T {
+	t.once.Do(func() {
+		t.table = t.build()
+		atomic.StoreInt32(&t.loaded, 1)
+	})
+
+	return t.table
+}
+
+func (t *lazyTable[T]) load() {
+	t.get()
+}
+
+func (t *lazyTable[T]) isLoaded() bool {
+	return atomic.LoadInt32(&t.loaded) == 1
+}
+
+func IsISO3166Alpha2(str string) bool {
+	_, ok := iso3166Codes.get().alpha2[str]
+	return ok
+}
+
+func IsISO3166Alpha3(str string) bool {
+	_, ok := iso3166Codes.get().alpha3[str]
+	return ok
+}
+
+func IsISO3166Numeric(str string) bool {
+	_, ok := iso3166Codes.get().numeric[str]
+	return ok
+}
+
+func IsISO4217(str string) bool {
+	_, ok := iso4217Codes.get().minorUnits[str]
+	return ok
+}
+
+func IsISO4217Numeric(str string) bool {
+	_, ok := iso4217Codes.get().numeric[str]
+	return ok
+}
+
+// ISO4217MinorUnits returns the number of decimal digits of the minor unit of
+// the currency. Currencies without a minor unit (e.g. XAU) report 0. The second
+// return value is false for unknown currency codes.
+func ISO4217MinorUnits(code string) (int, bool) {
+	minor, ok := iso4217Codes.get().minorUnits[code]
+	if minor == noMinorUnits {
+		minor = 0
+	}
+
+	return minor, ok
+}
+
+func IsISO639Alpha2(str string) bool {
+	_, ok := iso639Codes.get().alpha2[str]
+	return ok
+}
+
+// IsISO639Alpha3 accepts ISO 639-2 (terminology and bibliographic) and
+// ISO 639-3 codes.
+func IsISO639Alpha3(str string) bool {
+	_, ok := iso639Codes.get().alpha3[str]
+	return ok
+}
+
+func IsISO639(str string) bool {
+	return IsISO639Alpha2(str) || IsISO639Alpha3(str)
+}
+
+func IsISO15924(str string) bool {
+	_, ok := iso15924Codes.get()[str]
+	return ok
+}
+
+func IsIANATimezone(str string) bool {
+	_, ok := ianaTimezones.get()[str]
+	return ok
+}
+
+// LanguageTag is the structural decomposition of a BCP 47 language tag.
+// Subtags are stored in their canonical case.
+type LanguageTag struct {
+	Language   string
+	ExtLang    []string
+	Script     string
+	Region     string
+	Variants   []string
+	Extensions []string
+	PrivateUse []string
+}
+
+var ErrInvalidLanguageTag = errors.New("invalid BCP 47 language tag")
+
+// bcp47Grandfathered holds the irregular grandfathered tags that do not follow
+// the langtag production.
+var bcp47Grandfathered = map[string]struct{}{
+	"en-gb-oed": {}, "i-ami": {}, "i-bnn": {}, "i-default": {}, "i-enochian": {},
+	"i-hak": {}, "i-klingon": {}, "i-lux": {}, "i-mingo": {}, "i-navajo": {},
+	"i-pwn": {}, "i-tao": {}, "i-tay": {}, "i-tsu": {}, "sgn-be-fr": {},
+	"sgn-be-nl": {}, "sgn-ch-de": {},
+}
+
+func IsBCP47(str string) bool {
+	_, err := ParseBCP47(str)
+	return err == nil
+}
+
+// ParseBCP47 parses a language tag as defined by RFC 5646. Language, script
+// and region subtags are checked against the embedded ISO 639, ISO 15924 and
+// ISO 3166-1 / UN M.49 tables. Irregular grandfathered tags are accepted and
+// returned with only Language set.
+func ParseBCP47(str string) (LanguageTag, error) {
+	var tag LanguageTag
+
+	lower := strings.ToLower(str)
+	if _, ok := bcp47Grandfathered[lower]; ok {
+		tag.Language = lower
+		return tag, nil
+	}
+
+	subtags := strings.Split(lower, "-")
+	for _, subtag := range subtags {
+		if len(subtag) == 0 || len(subtag) > 8 || !isASCIIAlphaNumeric(subtag) {
+			return LanguageTag{}, ErrInvalidLanguageTag
+		}
+	}
+
+	i := 0
+	if subtags[0] == "x" {
+		return parseBCP47PrivateUse(tag, subtags[1:])
+	}
+
+	language := subtags[i]
+	if !isASCIIAlpha(language) || len(language) < 2 || len(language) == 4 {
+		return LanguageTag{}, ErrInvalidLanguageTag
+	}
+	if len(language) <= 3 && !IsISO639(language) {
+		return LanguageTag{}, ErrInvalidLanguageTag
+	}
+	tag.Language = language
+	i++
+
+	if len(language) <= 3 {
+		for len(tag.ExtLang) < 3 && i < len(subtags) && len(subtags[i]) == 3 && isASCIIAlpha(subtags[i]) {
+			if !IsISO639Alpha3(subtags[i]) {
+				return LanguageTag{}, ErrInvalidLanguageTag
+			}
+			tag.ExtLang = append(tag.ExtLang, subtags[i])
+			i++
+		}
+	}
+
+	if i < len(subtags) && len(subtags[i]) == 4 && isASCIIAlpha(subtags[i]) {
+		script := strings.ToUpper(subtags[i][:1]) + subtags[i][1:]
+		if !IsISO15924(script) {
+			return LanguageTag{}, ErrInvalidLanguageTag
+		}
+		tag.Script = script
+		i++
+	}
+
+	if i < len(subtags) && isBCP47Region(subtags[i]) {
+		region := strings.ToUpper(subtags[i])
+		_, isUNRegion := unM49Regions[region]
+		if !IsISO3166Alpha2(region) && !IsISO3166Numeric(region) && !isUNRegion && region != "EU" && region != "UN" && region != "XK" {
+			return LanguageTag{}, ErrInvalidLanguageTag
+		}
+		tag.Region = region
+		i++
+	}
+
+	for i < len(subtags) && isBCP47Variant(subtags[i]) {
+		for _, variant := range tag.Variants {
+			if variant == subtags[i] {
+				return LanguageTag{}, ErrInvalidLanguageTag
+			}
+		}
+		tag.Variants = append(tag.Variants, subtags[i])
+		i++
+	}
+
+	seen := make(map[string]struct{})
+	for i < len(subtags) && len(subtags[i]) == 1 && subtags[i] != "x" {
+		singleton := subtags[i]
+		if _, ok := seen[singleton]; ok {
+			return LanguageTag{}, ErrInvalidLanguageTag
+		}
+		seen[singleton] = struct{}{}
+		i++
+
+		start := i
+		for i < len(subtags) && len(subtags[i]) >= 2 {
+			i++
+		}
+		if start == i {
+			return LanguageTag{}, ErrInvalidLanguageTag
+		}
+		tag.Extensions = append(tag.Extensions, singleton+"-"+strings.Join(subtags[start:i], "-"))
+	}
+
+	if i < len(subtags) && subtags[i] == "x" {
+		return parseBCP47PrivateUse(tag, subtags[i+1:])
+	}
+
+	if i != len(subtags) {
+		return LanguageTag{}, ErrInvalidLanguageTag
+	}
+
+	return tag, nil
+}
+
+func parseBCP47PrivateUse(tag LanguageTag, subtags []string) (LanguageTag, error) {
+	if len(subtags) == 0 {
+		return LanguageTag{}, ErrInvalidLanguageTag
+	}
+	tag.PrivateUse = subtags
+
+	return tag, nil
+}
+
+func isBCP47Region(subtag string) bool {
+	return len(subtag) == 2 && isASCIIAlpha(subtag) || len(subtag) == 3 && isASCIIDigits(subtag)
+}
+
+func isBCP47Variant(subtag string) bool {
+	return len(subtag) >= 5 || len(subtag) == 4 && subtag[0] >= '0' && subtag[0] <= '9'
+}
+
+func isASCIIAlpha(str string) bool {
+	for i := 0; i < len(str); i++ {
+		if c := str[i] | 0x20; c < 'a' || c > 'z' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isASCIIDigits(str string) bool {
+	for i := 0; i < len(str); i++ {
+		if str[i] < '0' || str[i] > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isASCIIAlphaNumeric(str string) bool {
+	for i := 0; i < len(str); i++ {
+		if c := str[i]; (c|0x20 < 'a' || c|0x20 > 'z') && (c < '0' || c > '9') {
+			return false
+		}
+	}
+
+	return true
+}
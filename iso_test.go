@@ -0,0 +1,153 @@
+package validator_test
+
+import (
+	validator "github.com/MrWormHole/simple-validator"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestIsISO3166(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.True(validator.IsISO3166Alpha2("DE"))
+	assert.True(validator.IsISO3166Alpha2("GB"))
+	assert.False(validator.IsISO3166Alpha2("de"))
+	assert.False(validator.IsISO3166Alpha2("UK"))
+	assert.False(validator.IsISO3166Alpha2(""))
+
+	assert.True(validator.IsISO3166Alpha3("DEU"))
+	assert.True(validator.IsISO3166Alpha3("USA"))
+	assert.False(validator.IsISO3166Alpha3("GER"))
+
+	assert.True(validator.IsISO3166Numeric("276"))
+	assert.True(validator.IsISO3166Numeric("004"))
+	assert.False(validator.IsISO3166Numeric("4"))
+	assert.False(validator.IsISO3166Numeric("999"))
+}
+
+func TestIsISO4217(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.True(validator.IsISO4217("EUR"))
+	assert.True(validator.IsISO4217("JPY"))
+	assert.False(validator.IsISO4217("eur"))
+	assert.False(validator.IsISO4217("ABC"))
+	assert.True(validator.IsISO4217Numeric("978"))
+	assert.False(validator.IsISO4217Numeric("000"))
+
+	testCases := []struct {
+		code     string
+		minor    int
+		expected bool
+	}{
+		{"EUR", 2, true},
+		{"JPY", 0, true},
+		{"KWD", 3, true},
+		{"CLF", 4, true},
+		{"XAU", 0, true},
+		{"ABC", 0, false},
+	}
+
+	for _, t := range testCases {
+		minor, ok := validator.ISO4217MinorUnits(t.code)
+		assert.Equal(t.expected, ok, t.code)
+		assert.Equal(t.minor, minor, t.code)
+	}
+}
+
+func TestIsISO639(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.True(validator.IsISO639Alpha2("en"))
+	assert.False(validator.IsISO639Alpha2("EN"))
+	assert.False(validator.IsISO639Alpha2("zz"))
+	assert.True(validator.IsISO639Alpha3("deu"))
+	assert.True(validator.IsISO639Alpha3("ger"))
+	assert.True(validator.IsISO639Alpha3("yue"))
+	assert.False(validator.IsISO639Alpha3("qqq"))
+	assert.True(validator.IsISO639("fr"))
+	assert.True(validator.IsISO639("fra"))
+	assert.False(validator.IsISO639("f"))
+}
+
+func TestIsIANATimezone(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []testCase{
+		{"Europe/London", true},
+		{"America/New_York", true},
+		{"America/Argentina/Buenos_Aires", true},
+		{"UTC", true},
+		{"Etc/GMT+5", true},
+		{"Europe/Kiev", true},
+		{"europe/london", false},
+		{"Local", false},
+		{"Mars/Olympus_Mons", false},
+		{"", false},
+	}
+
+	for _, t := range testCases {
+		actual := validator.IsIANATimezone(t.param)
+		assert.Equal(t.expected, actual, t.param)
+	}
+}
+
+func TestIsBCP47(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []testCase{
+		{"en", true},
+		{"en-US", true},
+		{"zh-Hant-TW", true},
+		{"sr-Latn-RS", true},
+		{"es-419", true},
+		{"de-CH-1901", true},
+		{"sl-rozaj-biske", true},
+		{"zh-yue-HK", true},
+		{"en-US-u-ca-gregory", true},
+		{"en-a-bbb-x-a-ccc", true},
+		{"x-whatever", true},
+		{"i-klingon", true},
+		{"EN-us", true},
+		{"", false},
+		{"e", false},
+		{"en-", false},
+		{"en--US", false},
+		{"qq-US", false},
+		{"en-Qqqq", false},
+		{"en-QQ", false},
+		{"de-419-DE", false},
+		{"a-DE", false},
+		{"ar-a-aaa-b-bbb-a-ccc", false},
+		{"de-1901-1901", false},
+		{"en-u", false},
+		{"en-x", false},
+		{"en-US_POSIX", false},
+	}
+
+	for _, t := range testCases {
+		actual := validator.IsBCP47(t.param)
+		assert.Equal(t.expected, actual, t.param)
+	}
+}
+
+func TestParseBCP47(t *testing.T) {
+	assert := assert.New(t)
+
+	tag, err := validator.ParseBCP47("ZH-hant-tw-u-nu-hanidec-x-private")
+	assert.NoError(err)
+	assert.Equal(validator.LanguageTag{
+		Language:   "zh",
+		Script:     "Hant",
+		Region:     "TW",
+		Extensions: []string{"u-nu-hanidec"},
+		PrivateUse: []string{"private"},
+	}, tag)
+
+	tag, err = validator.ParseBCP47("sl-Latn-IT-rozaj-nedis")
+	assert.NoError(err)
+	assert.Equal([]string{"rozaj", "nedis"}, tag.Variants)
+
+	_, err = validator.ParseBCP47("en-US-")
+	assert.ErrorIs(err, validator.ErrInvalidLanguageTag)
+}
@@ -0,0 +1,188 @@
+package validator
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/rivo/uniseg"
+)
+
+// LengthUnit selects how the length of a string is counted.
+type LengthUnit int
+
+const (
+	// Bytes counts UTF-8 bytes, as len does.
+	Bytes LengthUnit = iota
+	// Runes counts Unicode code points.
+	Runes
+	// Graphemes counts extended grapheme clusters (UAX #29), the characters a
+	// user perceives: an e followed by a combining accent and a family emoji
+	// made of three people joined by zero width joiners both count as one.
+	Graphemes
+)
+
+func (u LengthUnit) String() string {
+	switch u {
+	case Bytes:
+		return "bytes"
+	case Runes:
+		return "runes"
+	case Graphemes:
+		return "graphemes"
+	}
+
+	return "LengthUnit(" + strconv.Itoa(int(u)) + ")"
+}
+
+// CharClass is a set of character classes. Classes can be combined with |.
+type CharClass uint
+
+const (
+	// Letters are Unicode letters (category L).
+	Letters CharClass = 1 << iota
+	// Marks are combining marks (category M) that do not follow another
+	// allowed character. Marks following an allowed character are always
+	// accepted as part of its grapheme cluster.
+	Marks
+	// Digits are decimal digits in any script (category Nd).
+	Digits
+	// Numbers are all numeric characters (category N), including Digits.
+	Numbers
+	// Punctuation is category P.
+	Punctuation
+	// Symbols are category S, which includes most emoji.
+	Symbols
+	// Spaces are space separators (category Zs).
+	Spaces
+	// ASCIILetters are a-z and A-Z.
+	ASCIILetters
+	// ASCIIDigits are 0-9.
+	ASCIIDigits
+)
+
+// TextPolicy constrains the length and the characters of a string.
+type TextPolicy struct {
+	// MinLength and MaxLength bound the length measured in Unit. A MaxLength
+	// of 0 means that there is no upper bound.
+	MinLength int
+	MaxLength int
+	Unit      LengthUnit
+	// Classes and Chars list the allowed characters. When both are empty any
+	// character is allowed.
+	Classes CharClass
+	Chars   string
+}
+
+// Length returns the length of str in the given unit.
+func Length(str string, unit LengthUnit) int {
+	switch unit {
+	case Runes:
+		return utf8.RuneCountInString(str)
+	case Graphemes:
+		return uniseg.GraphemeClusterCount(str)
+	}
+
+	return len(str)
+}
+
+// IsLength reports whether the length of str in the given unit lies within
+// the inclusive range [min, max].
+func IsLength(str string, min int, max int, unit LengthUnit) bool {
+	length := Length(str, unit)
+	return length >= min && length <= max
+}
+
+// IsMinLength reports whether str is at least min units long.
+func IsMinLength(str string, min int, unit LengthUnit) bool {
+	return Length(str, unit) >= min
+}
+
+// IsMaxLength reports whether str is at most max units long.
+func IsMaxLength(str string, max int, unit LengthUnit) bool {
+	return Length(str, unit) <= max
+}
+
+// IsText reports whether str is valid UTF-8 that satisfies the policy. For
+// example 3 to 30 graphemes of letters, digits and underscores:
+//
+//	validator.IsText(username, validator.TextPolicy{
+//		MinLength: 3,
+//		MaxLength: 30,
+//		Unit:      validator.Graphemes,
+//		Classes:   validator.Letters | validator.Digits,
+//		Chars:     "_",
+//	})
+//
+// Characters are checked per grapheme cluster: the first character of each
+// cluster must be allowed, and the combining marks and emoji modifiers that
+// extend it are accepted with it. A zero width joiner is only accepted between
+// two characters of the same cluster, as in emoji sequences.
+func IsText(str string, policy TextPolicy) bool {
+	if !utf8.ValidString(str) {
+		return false
+	}
+
+	length := Length(str, policy.Unit)
+	if length < policy.MinLength || policy.MaxLength > 0 && length > policy.MaxLength {
+		return false
+	}
+
+	if policy.Classes == 0 && policy.Chars == "" {
+		return true
+	}
+
+	state := -1
+	for rest := str; rest != ""; {
+		var cluster string
+		cluster, rest, _, state = uniseg.FirstGraphemeClusterInString(rest, state)
+		if !policy.allowsCluster(cluster) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (p TextPolicy) allowsCluster(cluster string) bool {
+	for i, r := range cluster {
+		if p.allows(r) {
+			continue
+		}
+
+		extender := i > 0 && (unicode.Is(unicode.M, r) || isEmojiModifier(r))
+		joiner := i > 0 && r == '\u200d' && i+utf8.RuneLen(r) < len(cluster)
+		if !extender && !joiner {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (p TextPolicy) allows(r rune) bool {
+	if strings.ContainsRune(p.Chars, r) {
+		return true
+	}
+
+	c := p.Classes
+	switch {
+	case c&ASCIILetters != 0 && r < utf8.RuneSelf && (r|0x20 >= 'a' && r|0x20 <= 'z'),
+		c&ASCIIDigits != 0 && r >= '0' && r <= '9',
+		c&Letters != 0 && unicode.IsLetter(r),
+		c&Marks != 0 && unicode.IsMark(r),
+		c&Digits != 0 && unicode.IsDigit(r),
+		c&Numbers != 0 && unicode.IsNumber(r),
+		c&Punctuation != 0 && unicode.IsPunct(r),
+		c&Symbols != 0 && unicode.IsSymbol(r),
+		c&Spaces != 0 && unicode.Is(unicode.Zs, r):
+		return true
+	}
+
+	return false
+}
+
+func isEmojiModifier(r rune) bool {
+	return r >= 0x1f3fb && r <= 0x1f3ff
+}
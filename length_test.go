@@ -0,0 +1,104 @@
+package validator_test
+
+import (
+	validator "github.com/MrWormHole/simple-validator"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestLength(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []struct {
+		param     string
+		bytes     int
+		runes     int
+		graphemes int
+	}{
+		{"", 0, 0, 0},
+		{"abc", 3, 3, 3},
+		{"é", 2, 1, 1},
+		{"e\u0301", 3, 2, 1},
+		{"日本語", 9, 3, 3},
+		{"👍🏽", 8, 2, 1},
+		{"👨\u200d👩\u200d👧", 18, 5, 1},
+		{"🇯🇵", 8, 2, 1},
+		{"\r\n", 2, 2, 1},
+	}
+
+	for _, t := range testCases {
+		assert.Equal(t.bytes, validator.Length(t.param, validator.Bytes), t.param)
+		assert.Equal(t.runes, validator.Length(t.param, validator.Runes), t.param)
+		assert.Equal(t.graphemes, validator.Length(t.param, validator.Graphemes), t.param)
+	}
+
+	assert.Equal("graphemes", validator.Graphemes.String())
+}
+
+func TestIsLength(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.True(validator.IsLength("👍🏽👍🏽👍🏽", 3, 3, validator.Graphemes))
+	assert.False(validator.IsLength("👍🏽👍🏽👍🏽", 3, 3, validator.Runes))
+	assert.False(validator.IsLength("👍🏽👍🏽👍🏽", 3, 3, validator.Bytes))
+	assert.True(validator.IsMinLength("ab", 2, validator.Bytes))
+	assert.False(validator.IsMinLength("日", 2, validator.Runes))
+	assert.True(validator.IsMaxLength("日本", 2, validator.Runes))
+	assert.False(validator.IsMaxLength("日本", 2, validator.Bytes))
+}
+
+func TestIsText(t *testing.T) {
+	assert := assert.New(t)
+
+	username := validator.TextPolicy{
+		MinLength: 3,
+		MaxLength: 30,
+		Unit:      validator.Graphemes,
+		Classes:   validator.Letters | validator.Digits,
+		Chars:     "_",
+	}
+	displayName := validator.TextPolicy{
+		MaxLength: 8,
+		Unit:      validator.Graphemes,
+		Classes:   validator.Letters | validator.Spaces | validator.Symbols,
+	}
+	ascii := validator.TextPolicy{
+		MinLength: 1,
+		Classes:   validator.ASCIILetters | validator.ASCIIDigits,
+		Chars:     "-.",
+	}
+
+	testCases := []struct {
+		param    string
+		policy   validator.TextPolicy
+		expected bool
+	}{
+		{"john_doe", username, true},
+		{"jöhn_2", username, true},
+		{"jöhn", username, true},
+		{"日本語", username, true},
+		{"١٢٣abc", username, true},
+		{"ab", username, false},
+		{"e\u0301e\u0301", username, false},
+		{"john doe", username, false},
+		{"john-doe", username, false},
+		{"john\u200d", username, false},
+		{"\u0301abc", username, false},
+		{"abc\xff", username, false},
+		{"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", username, false},
+		{"Ann 👍🏽", displayName, true},
+		{"👨\u200d👩\u200d👧 family", displayName, true},
+		{"Ann!", displayName, false},
+		{"Ann 👍🏽👍🏽👍🏽👍🏽👍🏽", displayName, false},
+		{"", displayName, true},
+		{"v1.2-rc", ascii, true},
+		{"", ascii, false},
+		{"café", ascii, false},
+		{"anything goes ✓", validator.TextPolicy{}, true},
+	}
+
+	for _, t := range testCases {
+		actual := validator.IsText(t.param, t.policy)
+		assert.Equal(t.expected, actual, t.param)
+	}
+}
@@ -0,0 +1,63 @@
+package validator
+
+import (
+	"errors"
+	"sync/atomic"
+)
+
+var (
+	// ErrTooLong is returned when an input exceeds the maximum input length.
+	ErrTooLong = errors.New("input too long")
+	// ErrInvalid is returned by the validators of WithMaxLength when the
+	// input is short enough but not valid.
+	ErrInvalid = errors.New("invalid input")
+)
+
+// maxInputLength is the global limit in bytes, 0 meaning no limit.
+var maxInputLength int64
+
+// SetMaxInputLength sets a global limit in bytes on the inputs accepted by
+// the format validators, from IsAlpha to IsHTMLEncoded, the other regular
+// expression based validators and the HTML validators, which reject longer
+// inputs without inspecting them. Other validators are
+// only limited when wrapped with WithMaxLength. A limit of 0, the default,
+// disables the check. It is safe to call concurrently with validation.
+func SetMaxInputLength(n int) {
+	if n < 0 {
+		n = 0
+	}
+
+	atomic.StoreInt64(&maxInputLength, int64(n))
+}
+
+// MaxInputLength returns the global limit set by SetMaxInputLength.
+func MaxInputLength() int {
+	return int(atomic.LoadInt64(&maxInputLength))
+}
+
+// exceedsMaxInputLength reports whether str is longer than the global limit.
+func exceedsMaxInputLength(str string) bool {
+	limit := atomic.LoadInt64(&maxInputLength)
+	return limit > 0 && int64(len(str)) > limit
+}
+
+// WithMaxLength returns a validator that rejects inputs longer than maxLength
+// bytes, or than the global limit, with ErrTooLong before running fn, and
+// reports inputs that fn rejects with ErrInvalid:
+//
+//	validateEmail := validator.WithMaxLength(254, validator.IsEmail)
+//	err := validateEmail(field) // nil, ErrTooLong or ErrInvalid
+//
+// A maxLength of 0 only applies the global limit.
+func WithMaxLength(maxLength int, fn func(string) bool) func(string) error {
+	return func(str string) error {
+		if maxLength > 0 && len(str) > maxLength || exceedsMaxInputLength(str) {
+			return ErrTooLong
+		}
+		if !fn(str) {
+			return ErrInvalid
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,96 @@
+package validator_test
+
+import (
+	validator "github.com/MrWormHole/simple-validator"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestWithMaxLength(t *testing.T) {
+	assert := assert.New(t)
+
+	validateEmail := validator.WithMaxLength(254, validator.IsEmail)
+
+	assert.NoError(validateEmail("john.doe@example.com"))
+	assert.ErrorIs(validateEmail("john.doe@"), validator.ErrInvalid)
+	assert.ErrorIs(validateEmail(strings.Repeat("a", 250)+"@example.com"), validator.ErrTooLong)
+	assert.ErrorIs(validateEmail(strings.Repeat("a", 50*1024*1024)), validator.ErrTooLong)
+
+	unlimited := validator.WithMaxLength(0, validator.IsAlpha)
+	assert.NoError(unlimited(strings.Repeat("a", 10000)))
+	assert.ErrorIs(unlimited("a1"), validator.ErrInvalid)
+}
+
+func TestSetMaxInputLength(t *testing.T) {
+	assert := assert.New(t)
+	defer validator.SetMaxInputLength(0)
+
+	assert.Equal(0, validator.MaxInputLength())
+
+	email := strings.Repeat("a", 60) + "@example.com"
+	dataURI := "data:text/plain;base64," + strings.Repeat("QUJD", 20)
+	html := "<b>" + strings.Repeat("a", 60) + "</b>"
+	policy := validator.HTMLPolicy{Tags: map[string][]string{"b": nil}}
+
+	assert.True(validator.IsEmail(email))
+	assert.True(validator.IsDataURI(dataURI))
+	assert.True(validator.IsHTML(html))
+
+	validator.SetMaxInputLength(64)
+	assert.Equal(64, validator.MaxInputLength())
+
+	assert.False(validator.IsEmail(email))
+	assert.False(validator.IsDataURI(dataURI))
+	assert.False(validator.IsHTML(html))
+	assert.Nil(validator.HTMLTags(html))
+	assert.Equal("", validator.SanitizeHTML(html, policy))
+	assert.Equal([]validator.HTMLViolation{{Reason: "input too long"}}, validator.CheckHTML(html, policy))
+	assert.Equal("input too long", validator.CheckHTML(html, policy)[0].String())
+	assert.True(validator.IsEmail("john.doe@example.com"))
+	assert.ErrorIs(validator.WithMaxLength(100, validator.IsEmail)(email), validator.ErrTooLong)
+
+	validator.SetMaxInputLength(-1)
+	assert.Equal(0, validator.MaxInputLength())
+	assert.True(validator.IsEmail(email))
+}
+
+func TestSetMaxInputLengthMatchers(t *testing.T) {
+	assert := assert.New(t)
+	defer validator.SetMaxInputLength(0)
+
+	testCases := []struct {
+		fn    func(string) bool
+		param string
+	}{
+		{validator.IsAlpha, "abc"},
+		{validator.IsAlphaNumeric, "abc123"},
+		{validator.IsNumeric, "-12.5"},
+		{validator.IsNumber, "123"},
+		{validator.IsHexadecimal, "ff00"},
+		{validator.IsHexcolor, "#ff00ff"},
+		{validator.IsBase64, "QUJD"},
+		{validator.IsBase64URL, "QUJD"},
+		{validator.IsISBN10, "3836221195"},
+		{validator.IsISBN13, "9783836221191"},
+		{validator.IsUUID, "a987fbc9-4bed-3078-cf07-9141ba07c9f3"},
+		{validator.IsUUID3, "a987fbc9-4bed-3078-af07-9141ba07c9f3"},
+		{validator.IsUUID4, "57b73598-8764-4ad0-a76a-679bb6640eb1"},
+		{validator.IsUUID5, "987fbc97-4bed-5078-9f07-9141ba07c9f3"},
+		{validator.IsUUIDMixed, "A987FBC9-4bed-3078-cf07-9141ba07c9f3"},
+		{validator.IsUUID3Mixed, "A987FBC9-4bed-3078-af07-9141ba07c9f3"},
+		{validator.IsUUID4Mixed, "57B73598-8764-4ad0-a76a-679bb6640eb1"},
+		{validator.IsUUID5Mixed, "987FBC97-4bed-5078-9f07-9141ba07c9f3"},
+		{validator.IsASCII, "abc"},
+		{validator.IsPrintableASCII, "abc"},
+		{validator.HasMultibyteChar, "héllo"},
+		{validator.IsETHAddress, "0x52908400098527886E0F7030069857D2E4169EE7"},
+	}
+
+	for _, t := range testCases {
+		validator.SetMaxInputLength(len(t.param))
+		assert.True(t.fn(t.param), t.param)
+		validator.SetMaxInputLength(len(t.param) - 1)
+		assert.False(t.fn(t.param), t.param)
+	}
+}
@@ -0,0 +1,355 @@
+package validator
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// NumberFormat describes how a locale writes numbers. Group is 0 when digit
+// grouping is not used. IndianGrouping selects the 2-digit secondary groups of
+// the Indian numbering system (12,34,567.89).
+type NumberFormat struct {
+	Decimal        rune
+	Group          rune
+	IndianGrouping bool
+}
+
+var (
+	ErrUnknownLocale       = errors.New("unknown locale")
+	ErrInvalidLocaleNumber = errors.New("invalid locale number")
+)
+
+var (
+	pointComma      = NumberFormat{Decimal: '.', Group: ','}
+	commaPoint      = NumberFormat{Decimal: ',', Group: '.'}
+	commaSpace      = NumberFormat{Decimal: ',', Group: ' '}
+	pointApostrophe = NumberFormat{Decimal: '.', Group: '\''}
+	indian          = NumberFormat{Decimal: '.', Group: ',', IndianGrouping: true}
+
+	numberFormats = map[string]NumberFormat{
+		"en": pointComma, "ja": pointComma, "zh": pointComma, "ko": pointComma,
+		"th": pointComma, "he": pointComma, "ms": pointComma, "tl": pointComma,
+		"de": commaPoint, "es": commaPoint, "it": commaPoint, "nl": commaPoint,
+		"pt": commaPoint, "id": commaPoint, "tr": commaPoint, "da": commaPoint,
+		"el": commaPoint, "ro": commaPoint, "hr": commaPoint, "sl": commaPoint,
+		"sr": commaPoint, "vi": commaPoint,
+		"fr": commaSpace, "ru": commaSpace, "pl": commaSpace, "cs": commaSpace,
+		"sk": commaSpace, "sv": commaSpace, "fi": commaSpace, "nb": commaSpace,
+		"no": commaSpace, "uk": commaSpace, "hu": commaSpace, "bg": commaSpace,
+		"lt": commaSpace, "lv": commaSpace, "et": commaSpace, "pt-PT": commaSpace,
+		"fr-CH": commaSpace, "fr-CA": commaSpace,
+		"de-CH": pointApostrophe, "de-LI": pointApostrophe, "it-CH": pointApostrophe,
+		"hi": indian, "en-IN": indian, "bn": indian,
+	}
+)
+
+// currencySymbols maps currency symbols to their ISO 4217 code. Symbols shared
+// by several currencies map to the empty string.
+var currencySymbols = map[string]string{
+	"US$": "USD", "A$": "AUD", "R$": "BRL", "NZ$": "NZD", "HK$": "HKD", "S$": "SGD",
+	"€": "EUR", "£": "GBP", "₹": "INR", "₽": "RUB", "₩": "KRW", "₺": "TRY",
+	"₴": "UAH", "₪": "ILS", "฿": "THB", "₫": "VND", "₱": "PHP", "₦": "NGN",
+	"zł": "PLN", "Kč": "CZK", "Ft": "HUF", "lei": "RON",
+	"$": "", "¥": "", "kr": "", "kr.": "",
+}
+
+var currencySymbolsByLength = sortedCurrencySymbols()
+
+func sortedCurrencySymbols() []string {
+	symbols := make([]string, 0, len(currencySymbols))
+	for symbol := range currencySymbols {
+		symbols = append(symbols, symbol)
+	}
+	sort.Slice(symbols, func(i, j int) bool {
+		if len(symbols[i]) != len(symbols[j]) {
+			return len(symbols[i]) > len(symbols[j])
+		}
+		return symbols[i] < symbols[j]
+	})
+
+	return symbols
+}
+
+// NumberFormatForLocale returns the number format of a BCP 47 locale such as
+// de-DE, falling back to its language when the region has no override.
+func NumberFormatForLocale(locale string) (NumberFormat, bool) {
+	tag, err := ParseBCP47(locale)
+	if err != nil {
+		return NumberFormat{}, false
+	}
+
+	if tag.Region != "" {
+		if format, ok := numberFormats[tag.Language+"-"+tag.Region]; ok {
+			return format, true
+		}
+	}
+	format, ok := numberFormats[tag.Language]
+
+	return format, ok
+}
+
+func IsLocaleNumber(str string, locale string) bool {
+	_, err := ParseLocaleNumber(str, locale)
+	return err == nil
+}
+
+// ParseLocaleNumber validates a number written in the locale's format, such as
+// 1.234,56 for de-DE, and returns it as a canonical decimal string (1234.56).
+// Grouping separators are optional but must be correctly placed when present.
+// A leading sign or accounting parentheses, as in (123.45), mark negatives.
+func ParseLocaleNumber(str string, locale string) (string, error) {
+	format, ok := NumberFormatForLocale(locale)
+	if !ok {
+		return "", ErrUnknownLocale
+	}
+
+	return ParseNumber(str, format)
+}
+
+// ParseNumber is ParseLocaleNumber with explicit separators.
+func ParseNumber(str string, format NumberFormat) (string, error) {
+	value, negative, ok := stripAccountingNegative(strings.TrimSpace(str))
+	if !ok {
+		return "", ErrInvalidLocaleNumber
+	}
+
+	sign, value := cutSign(value)
+	if sign != "" && negative {
+		return "", ErrInvalidLocaleNumber
+	}
+	if sign == "-" {
+		negative = true
+	}
+
+	return canonicalNumber(value, negative, format)
+}
+
+type Amount struct {
+	// Value is the canonical decimal representation of the amount.
+	Value string
+	// Currency is the ISO 4217 code, empty when only an ambiguous symbol such
+	// as $ was given.
+	Currency string
+	// Symbol is the currency symbol or code exactly as written.
+	Symbol string
+}
+
+func IsLocaleCurrency(str string, locale string) bool {
+	_, err := ParseLocaleCurrency(str, locale)
+	return err == nil
+}
+
+// ParseLocaleCurrency validates a currency amount written in the locale's
+// format with a leading or trailing currency symbol or ISO 4217 code, such as
+// $1,234.56, 1.234,56 €, EUR 12,50 or (£5.00).
+func ParseLocaleCurrency(str string, locale string) (Amount, error) {
+	format, ok := NumberFormatForLocale(locale)
+	if !ok {
+		return Amount{}, ErrUnknownLocale
+	}
+
+	return ParseCurrency(str, format)
+}
+
+// ParseCurrency is ParseLocaleCurrency with explicit separators.
+func ParseCurrency(str string, format NumberFormat) (Amount, error) {
+	value, negative, ok := stripAccountingNegative(strings.TrimSpace(str))
+	if !ok {
+		return Amount{}, ErrInvalidLocaleNumber
+	}
+
+	sign, value := cutSign(value)
+	symbol, value, ok := cutCurrency(value)
+	if !ok {
+		return Amount{}, ErrInvalidLocaleNumber
+	}
+	if sign == "" {
+		sign, value = cutSign(value)
+	}
+	if sign != "" && negative {
+		return Amount{}, ErrInvalidLocaleNumber
+	}
+	if sign == "-" {
+		negative = true
+	}
+
+	canonical, err := canonicalNumber(value, negative, format)
+	if err != nil {
+		return Amount{}, err
+	}
+
+	currency, known := currencySymbols[symbol]
+	if !known {
+		currency = symbol
+	}
+
+	return Amount{Value: canonical, Currency: currency, Symbol: symbol}, nil
+}
+
+func IsLocalePercent(str string, locale string) bool {
+	_, err := ParseLocalePercent(str, locale)
+	return err == nil
+}
+
+// ParseLocalePercent validates a percentage such as 12,5 % or -3% written in
+// the locale's format and returns the number of percent as a canonical decimal
+// string (12.5).
+func ParseLocalePercent(str string, locale string) (string, error) {
+	format, ok := NumberFormatForLocale(locale)
+	if !ok {
+		return "", ErrUnknownLocale
+	}
+
+	return ParsePercent(str, format)
+}
+
+// ParsePercent is ParseLocalePercent with explicit separators.
+func ParsePercent(str string, format NumberFormat) (string, error) {
+	value := strings.TrimSpace(str)
+	if !strings.HasSuffix(value, "%") {
+		return "", ErrInvalidLocaleNumber
+	}
+
+	return ParseNumber(trimNumberSpace(strings.TrimSuffix(value, "%")), format)
+}
+
+func stripAccountingNegative(str string) (string, bool, bool) {
+	if !strings.HasPrefix(str, "(") {
+		return str, false, !strings.HasSuffix(str, ")")
+	}
+	if !strings.HasSuffix(str, ")") {
+		return str, false, false
+	}
+
+	return trimNumberSpace(str[1 : len(str)-1]), true, true
+}
+
+func cutSign(str string) (string, string) {
+	switch {
+	case strings.HasPrefix(str, "-"), strings.HasPrefix(str, "+"):
+		return str[:1], trimNumberSpace(str[1:])
+	case strings.HasPrefix(str, "\u2212"):
+		return "-", trimNumberSpace(str[len("\u2212"):])
+	}
+
+	return "", str
+}
+
+func cutCurrency(str string) (string, string, bool) {
+	for _, symbol := range currencySymbolsByLength {
+		if strings.HasPrefix(str, symbol) {
+			return symbol, trimNumberSpace(str[len(symbol):]), true
+		}
+		if strings.HasSuffix(str, symbol) {
+			return symbol, trimNumberSpace(str[:len(str)-len(symbol)]), true
+		}
+	}
+
+	if len(str) > 3 && IsISO4217(str[:3]) {
+		return str[:3], trimNumberSpace(str[3:]), true
+	}
+	if len(str) > 3 && IsISO4217(str[len(str)-3:]) {
+		return str[len(str)-3:], trimNumberSpace(str[:len(str)-3]), true
+	}
+
+	return "", str, false
+}
+
+func trimNumberSpace(str string) string {
+	return strings.TrimFunc(str, isSpaceSeparator)
+}
+
+func isSpaceSeparator(r rune) bool {
+	return r == ' ' || r == '\u00a0' || r == '\u202f'
+}
+
+func isGroupSeparator(r rune, group rune) bool {
+	switch {
+	case group == 0:
+		return false
+	case isSpaceSeparator(group):
+		return isSpaceSeparator(r)
+	case group == '\'':
+		return r == '\'' || r == '\u2019'
+	}
+
+	return r == group
+}
+
+func canonicalNumber(str string, negative bool, format NumberFormat) (string, error) {
+	if str == "" || format.Decimal == format.Group {
+		return "", ErrInvalidLocaleNumber
+	}
+
+	var groups []string
+	var integer strings.Builder
+	var fraction string
+	start := 0
+	for i, r := range str {
+		switch {
+		case r >= '0' && r <= '9':
+			continue
+		case r == format.Decimal:
+			fraction = str[i+utf8.RuneLen(r):]
+			if fraction == "" || !isASCIIDigits(fraction) {
+				return "", ErrInvalidLocaleNumber
+			}
+		case isGroupSeparator(r, format.Group):
+			groups = append(groups, str[start:i])
+			start = i + utf8.RuneLen(r)
+			continue
+		default:
+			return "", ErrInvalidLocaleNumber
+		}
+
+		groups = append(groups, str[start:i])
+		start = -1
+		break
+	}
+	if start != -1 {
+		groups = append(groups, str[start:])
+	}
+
+	if !isValidGrouping(groups, format.IndianGrouping) {
+		return "", ErrInvalidLocaleNumber
+	}
+	for _, group := range groups {
+		integer.WriteString(group)
+	}
+
+	digits := strings.TrimLeft(integer.String(), "0")
+	if digits == "" {
+		digits = "0"
+	}
+	if fraction != "" {
+		digits += "." + fraction
+	}
+	if negative && strings.Trim(digits, "0.") != "" {
+		digits = "-" + digits
+	}
+
+	return digits, nil
+}
+
+func isValidGrouping(groups []string, indian bool) bool {
+	if len(groups) == 1 {
+		return true
+	}
+
+	for i, group := range groups {
+		size := 3
+		if indian && i < len(groups)-1 {
+			size = 2
+		}
+		switch {
+		case i == 0 && (len(group) < 1 || len(group) > size):
+			return false
+		case i > 0 && len(group) != size:
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,143 @@
+package validator_test
+
+import (
+	validator "github.com/MrWormHole/simple-validator"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseLocaleNumber(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []struct {
+		param    string
+		locale   string
+		expected string
+		valid    bool
+	}{
+		{"1,234.56", "en-US", "1234.56", true},
+		{"1234.56", "en-US", "1234.56", true},
+		{"1,234,567", "en", "1234567", true},
+		{"-1,234.5", "en", "-1234.5", true},
+		{"(123.45)", "en", "-123.45", true},
+		{"( 123.45 )", "en", "-123.45", true},
+		{"+0.50", "en", "0.50", true},
+		{"-0.00", "en", "0.00", true},
+		{"007", "en", "7", true},
+		{".5", "en", "0.5", true},
+		{"1.234,56", "de-DE", "1234.56", true},
+		{"1.234.567,8", "de", "1234567.8", true},
+		{"1 234,56", "fr-FR", "1234.56", true},
+		{"1 234,56", "fr", "1234.56", true},
+		{"1 234,56", "fr", "1234.56", true},
+		{"1'234.56", "de-CH", "1234.56", true},
+		{"1’234.56", "de-CH", "1234.56", true},
+		{"12,34,567.89", "en-IN", "1234567.89", true},
+		{"−5", "en", "-5", true},
+		{"1,234.56", "de-DE", "", false},
+		{"1,23.56", "en", "", false},
+		{"1,2345", "en", "", false},
+		{",123", "en", "", false},
+		{"1,234,", "en", "", false},
+		{"1.234.56", "en", "", false},
+		{"1.", "en", "", false},
+		{"(-5)", "en", "", false},
+		{"(5", "en", "", false},
+		{"5)", "en", "", false},
+		{"1e5", "en", "", false},
+		{"", "en", "", false},
+		{"-", "en", "", false},
+		{"1,234,567.89", "en-IN", "", false},
+	}
+
+	for _, t := range testCases {
+		actual, err := validator.ParseLocaleNumber(t.param, t.locale)
+		if t.valid {
+			assert.NoError(err, t.param)
+			assert.Equal(t.expected, actual, t.param)
+		} else {
+			assert.ErrorIs(err, validator.ErrInvalidLocaleNumber, t.param)
+		}
+		assert.Equal(t.valid, validator.IsLocaleNumber(t.param, t.locale), t.param)
+	}
+
+	_, err := validator.ParseLocaleNumber("1", "xx-YY")
+	assert.ErrorIs(err, validator.ErrUnknownLocale)
+}
+
+func TestParseNumber(t *testing.T) {
+	assert := assert.New(t)
+
+	actual, err := validator.ParseNumber("1_234|5", validator.NumberFormat{Decimal: '|', Group: '_'})
+	assert.NoError(err)
+	assert.Equal("1234.5", actual)
+
+	_, err = validator.ParseNumber("1,234", validator.NumberFormat{Decimal: '.'})
+	assert.ErrorIs(err, validator.ErrInvalidLocaleNumber)
+}
+
+func TestParseLocaleCurrency(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []struct {
+		param    string
+		locale   string
+		expected validator.Amount
+		valid    bool
+	}{
+		{"$1,234.56", "en-US", validator.Amount{Value: "1234.56", Symbol: "$"}, true},
+		{"-$5", "en-US", validator.Amount{Value: "-5", Symbol: "$"}, true},
+		{"$-5", "en-US", validator.Amount{Value: "-5", Symbol: "$"}, true},
+		{"($5.00)", "en-US", validator.Amount{Value: "-5.00", Symbol: "$"}, true},
+		{"US$ 10", "en", validator.Amount{Value: "10", Currency: "USD", Symbol: "US$"}, true},
+		{"1.234,56 €", "de-DE", validator.Amount{Value: "1234.56", Currency: "EUR", Symbol: "€"}, true},
+		{"EUR 12,50", "de", validator.Amount{Value: "12.50", Currency: "EUR", Symbol: "EUR"}, true},
+		{"12,50 CHF", "fr", validator.Amount{Value: "12.50", Currency: "CHF", Symbol: "CHF"}, true},
+		{"£5", "en-GB", validator.Amount{Value: "5", Currency: "GBP", Symbol: "£"}, true},
+		{"1 234,56 zł", "pl", validator.Amount{Value: "1234.56", Currency: "PLN", Symbol: "zł"}, true},
+		{"1,234.56", "en", validator.Amount{}, false},
+		{"ABC 5", "en", validator.Amount{}, false},
+		{"$", "en", validator.Amount{}, false},
+		{"€1,234.56", "de", validator.Amount{}, false},
+	}
+
+	for _, t := range testCases {
+		actual, err := validator.ParseLocaleCurrency(t.param, t.locale)
+		if t.valid {
+			assert.NoError(err, t.param)
+			assert.Equal(t.expected, actual, t.param)
+		} else {
+			assert.Error(err, t.param)
+		}
+		assert.Equal(t.valid, validator.IsLocaleCurrency(t.param, t.locale), t.param)
+	}
+}
+
+func TestParseLocalePercent(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []struct {
+		param    string
+		locale   string
+		expected string
+		valid    bool
+	}{
+		{"12.5%", "en", "12.5", true},
+		{"12,5 %", "fr", "12.5", true},
+		{"-3%", "de", "-3", true},
+		{"(3%)", "en", "", false},
+		{"12.5", "en", "", false},
+		{"%", "en", "", false},
+	}
+
+	for _, t := range testCases {
+		actual, err := validator.ParseLocalePercent(t.param, t.locale)
+		if t.valid {
+			assert.NoError(err, t.param)
+			assert.Equal(t.expected, actual, t.param)
+		} else {
+			assert.Error(err, t.param)
+		}
+		assert.Equal(t.valid, validator.IsLocalePercent(t.param, t.locale), t.param)
+	}
+}
@@ -0,0 +1,197 @@
+package validator
+
+// Hand-written matchers for the simple character-class and fixed-layout
+// patterns of regexp.go. They do not allocate and accept exactly the strings
+// matched by the corresponding regular expressions, which are kept as the
+// reference implementation and checked against these in matcher_test.go.
+
+func isASCIIAlphaByte(c byte) bool {
+	return c|0x20 >= 'a' && c|0x20 <= 'z'
+}
+
+func isDigitByte(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isBase64Byte(c byte, url bool) bool {
+	if isASCIIAlphaByte(c) || isDigitByte(c) {
+		return true
+	}
+	if url {
+		return c == '-' || c == '_'
+	}
+
+	return c == '+' || c == '/'
+}
+
+// matchAlpha matches alphaRegexString.
+func matchAlpha(str string) bool {
+	return str != "" && isASCIIAlpha(str)
+}
+
+// matchAlphaNumeric matches alphaNumericRegexString.
+func matchAlphaNumeric(str string) bool {
+	return str != "" && isASCIIAlphaNumeric(str)
+}
+
+// matchNumber matches numberRegexString.
+func matchNumber(str string) bool {
+	return str != "" && isASCIIDigits(str)
+}
+
+// matchInteger matches integerRegexString.
+func matchInteger(str string) bool {
+	if str != "" && (str[0] == '+' || str[0] == '-') {
+		str = str[1:]
+	}
+
+	return matchNumber(str)
+}
+
+// matchNumeric matches numericRegexString.
+func matchNumeric(str string) bool {
+	if str != "" && (str[0] == '+' || str[0] == '-') {
+		str = str[1:]
+	}
+
+	i := 0
+	for i < len(str) && isDigitByte(str[i]) {
+		i++
+	}
+	if i == 0 {
+		return false
+	}
+	if i == len(str) {
+		return true
+	}
+
+	return str[i] == '.' && matchNumber(str[i+1:])
+}
+
+// matchHexadecimal matches hexadecimalRegexString.
+func matchHexadecimal(str string) bool {
+	if len(str) > 2 && str[0] == '0' && str[1]|0x20 == 'x' && isHexString(str[2:]) {
+		return true
+	}
+
+	return str != "" && isHexString(str)
+}
+
+// matchHexcolor matches hexcolorRegexString.
+func matchHexcolor(str string) bool {
+	return (len(str) == 4 || len(str) == 7) && str[0] == '#' && isHexString(str[1:])
+}
+
+// matchBase64 matches base64RegexString, or base64URLRegexString when url is
+// set.
+func matchBase64(str string, url bool) bool {
+	if str == "" || len(str)%4 != 0 {
+		return false
+	}
+
+	end := len(str)
+	if str[end-1] == '=' {
+		end--
+		if str[end-1] == '=' {
+			end--
+		}
+	}
+	for i := 0; i < end; i++ {
+		if !isBase64Byte(str[i], url) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchISBN10 matches isbn10RegexString.
+func matchISBN10(str string) bool {
+	return len(str) == 10 && isASCIIDigits(str[:9]) && (isDigitByte(str[9]) || str[9] == 'X')
+}
+
+// matchISBN13 matches isbn13RegexString.
+func matchISBN13(str string) bool {
+	return len(str) == 13 && str[0] == '9' && str[1] == '7' && (str[2] == '8' || str[2] == '9') && isASCIIDigits(str[3:])
+}
+
+// matchUUID matches the uuid*RegexString patterns. version is the required
+// version digit, or 0 for any; the RFC 4122 variant is required for versions
+// 4 and 5 only, as in the patterns. mixed allows upper-case hexadecimal
+// letters.
+func matchUUID(str string, version byte, mixed bool) bool {
+	if len(str) != 36 || str[8] != '-' || str[13] != '-' || str[18] != '-' || str[23] != '-' {
+		return false
+	}
+
+	for i := 0; i < len(str); i++ {
+		if i == 8 || i == 13 || i == 18 || i == 23 {
+			continue
+		}
+		if c := str[i]; !isDigitByte(c) && (c < 'a' || c > 'f') && (!mixed || c < 'A' || c > 'F') {
+			return false
+		}
+	}
+
+	if version != 0 && str[14] != version {
+		return false
+	}
+	if version == '4' || version == '5' {
+		variant := str[19]
+		if mixed {
+			variant |= 0x20
+		}
+		return variant == '8' || variant == '9' || variant == 'a' || variant == 'b'
+	}
+
+	return true
+}
+
+// matchASCII matches asciiRegexString.
+func matchASCII(str string) bool {
+	for i := 0; i < len(str); i++ {
+		if str[i] >= 0x80 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchPrintableASCII matches printableASCIIRegexString.
+func matchPrintableASCII(str string) bool {
+	for i := 0; i < len(str); i++ {
+		if str[i] < 0x20 || str[i] > 0x7e {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchETHAddress matches ethAddressRegexString, or its lower-case or
+// upper-case only variants when letterCase is 'a' or 'A'.
+func matchETHAddress(str string, letterCase byte) bool {
+	if len(str) != 42 || str[0] != '0' || str[1] != 'x' {
+		return false
+	}
+
+	for i := 2; i < len(str); i++ {
+		c := str[i]
+		switch {
+		case isDigitByte(c):
+		case c >= 'a' && c <= 'f':
+			if letterCase == 'A' {
+				return false
+			}
+		case c >= 'A' && c <= 'F':
+			if letterCase == 'a' {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,155 @@
+package validator
+
+import (
+	"math/rand"
+	"testing"
+)
+
+var matcherReferences = []struct {
+	name    string
+	regex   *lazyRegexp
+	matcher func(string) bool
+}{
+	{"alpha", alphaRegex, matchAlpha},
+	{"alphaNumeric", alphaNumericRegex, matchAlphaNumeric},
+	{"integer", integerRegex, matchInteger},
+	{"numeric", numericRegex, matchNumeric},
+	{"number", numberRegex, matchNumber},
+	{"hexadecimal", hexadecimalRegex, matchHexadecimal},
+	{"hexcolor", hexcolorRegex, matchHexcolor},
+	{"base64", base64Regex, func(str string) bool { return matchBase64(str, false) }},
+	{"base64URL", base64URLRegex, func(str string) bool { return matchBase64(str, true) }},
+	{"isbn10", isbn10Regex, matchISBN10},
+	{"isbn13", isbn13Regex, matchISBN13},
+	{"uuid3", uuid3Regex, func(str string) bool { return matchUUID(str, '3', false) }},
+	{"uuid4", uuid4Regex, func(str string) bool { return matchUUID(str, '4', false) }},
+	{"uuid5", uuid5Regex, func(str string) bool { return matchUUID(str, '5', false) }},
+	{"uuid", uuidRegex, func(str string) bool { return matchUUID(str, 0, false) }},
+	{"uuid3Mixed", uuid3MixedRegex, func(str string) bool { return matchUUID(str, '3', true) }},
+	{"uuid4Mixed", uuid4MixedRegex, func(str string) bool { return matchUUID(str, '4', true) }},
+	{"uuid5Mixed", uuid5MixedRegex, func(str string) bool { return matchUUID(str, '5', true) }},
+	{"uuidMixed", uuidMixedRegex, func(str string) bool { return matchUUID(str, 0, true) }},
+	{"ascii", asciiRegex, matchASCII},
+	{"printableASCII", printableASCIIRegex, matchPrintableASCII},
+	{"multibyteChar", multibyteCharRegex, func(str string) bool { return !matchASCII(str) }},
+	{"ethAddress", ethAddressRegex, func(str string) bool { return matchETHAddress(str, 0) }},
+	{"ethAddressLower", ethAddressRegexLower, func(str string) bool { return matchETHAddress(str, 'a') }},
+	{"ethAddressUpper", ethAddressRegexUpper, func(str string) bool { return matchETHAddress(str, 'A') }},
+}
+
+var matcherSeeds = []string{
+	"", "a", "Z", "abc", "abc1", "0", "+1", "-1.5", "1.", ".5", "+-1", "1.2.3",
+	"0x", "0X1f", "0xg", "#fff", "#FFFFFF", "#ffff", "fff",
+	"QUJD", "QUI=", "QQ==", "Q===", "=QQ=", "a-_b", "a+/b", "QUJDRA",
+	"0306406152", "030640615X", "030640615x", "9780306406157", "9770306406157",
+	"a987fbc9-4bed-3078-cf07-9141ba07c9f3",
+	"57b73598-8764-4ad0-a76a-679bb6640eb1",
+	"987FBC97-4BED-5078-AF07-9141BA07C9F3",
+	"987fbc97-4bed-5078-9f07-9141ba07c9f3",
+	"987fbc97-4bed-5078-cf07-9141ba07c9f3",
+	"987fbc9-4bed-5078-af07-9141ba07c9f3x",
+	"0x52908400098527886E0F7030069857D2E4169EE7",
+	"0x8617e340b3d01fa5f11f306f4090fd50e238070d",
+	"0xde709f2102306220921060314715629080e2fb77",
+	"0xde709f2102306220921060314715629080e2fb7g",
+	"\x00", "\x1f", "\x7f", "\x80", "\xff", "é", "日本", "abc\n", "abc\xff",
+}
+
+// matcherAlphabet biases random inputs towards the characters that the
+// patterns care about.
+const matcherAlphabet = "0123456789abcdefABCDEFxXgzZ+-./_=#\x00\x7f\x80\xffé"
+
+func TestMatchersAgreeWithRegexes(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	inputs := append([]string{}, matcherSeeds...)
+	for i := 0; i < 20000; i++ {
+		seed := matcherSeeds[random.Intn(len(matcherSeeds))]
+		inputs = append(inputs, mutateMatcherInput(random, seed))
+	}
+
+	for _, reference := range matcherReferences {
+		for _, input := range inputs {
+			if expected, actual := reference.regex.MatchString(input), reference.matcher(input); expected != actual {
+				t.Errorf("%s(%q) = %v, regex gives %v", reference.name, input, actual, expected)
+			}
+		}
+	}
+}
+
+func mutateMatcherInput(random *rand.Rand, str string) string {
+	b := []byte(str)
+	for n := random.Intn(3) + 1; n > 0; n-- {
+		c := matcherAlphabet[random.Intn(len(matcherAlphabet))]
+		switch position := random.Intn(len(b) + 1); random.Intn(3) {
+		case 0:
+			b = append(b[:position], append([]byte{c}, b[position:]...)...)
+		case 1:
+			if position < len(b) {
+				b[position] = c
+			}
+		case 2:
+			if position < len(b) {
+				b = append(b[:position], b[position+1:]...)
+			}
+		}
+	}
+
+	return string(b)
+}
+
+func FuzzMatchers(f *testing.F) {
+	for _, seed := range matcherSeeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, input string) {
+		for _, reference := range matcherReferences {
+			if expected, actual := reference.regex.MatchString(input), reference.matcher(input); expected != actual {
+				t.Errorf("%s(%q) = %v, regex gives %v", reference.name, input, actual, expected)
+			}
+		}
+	})
+}
+
+func TestMatchersDoNotAllocate(t *testing.T) {
+	for _, reference := range matcherReferences {
+		allocations := testing.AllocsPerRun(100, func() {
+			for _, input := range matcherSeeds {
+				reference.matcher(input)
+			}
+		})
+		if allocations != 0 {
+			t.Errorf("%s allocates %v times per run", reference.name, allocations)
+		}
+	}
+}
+
+func BenchmarkMatchers(b *testing.B) {
+	inputs := map[string]string{
+		"alpha":       "abcdefghijklmnopqrstuvwxyzABCDEF",
+		"numeric":     "-1234567890.0987654321",
+		"hexadecimal": "0xdeadbeefcafebabe",
+		"base64":      "SGVsbG8sIFdvcmxkISBIZWxsbywgV29ybGQh",
+		"uuid4":       "57b73598-8764-4ad0-a76a-679bb6640eb1",
+		"ascii":       "The quick brown fox jumps over the lazy dog",
+		"ethAddress":  "0x52908400098527886E0F7030069857D2E4169EE7",
+	}
+
+	for _, reference := range matcherReferences {
+		input, ok := inputs[reference.name]
+		if !ok {
+			continue
+		}
+		b.Run(reference.name+"/regexp", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				reference.regex.MatchString(input)
+			}
+		})
+		b.Run(reference.name+"/matcher", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				reference.matcher(input)
+			}
+		})
+	}
+}
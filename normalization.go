@@ -0,0 +1,111 @@
+package validator
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// NormalizationForm is a Unicode normalization form.
+type NormalizationForm int
+
+const (
+	NFC NormalizationForm = iota
+	NFD
+	NFKC
+	NFKD
+)
+
+var normalizationForms = [...]norm.Form{NFC: norm.NFC, NFD: norm.NFD, NFKC: norm.NFKC, NFKD: norm.NFKD}
+
+func (f NormalizationForm) String() string {
+	switch f {
+	case NFC:
+		return "NFC"
+	case NFD:
+		return "NFD"
+	case NFKC:
+		return "NFKC"
+	case NFKD:
+		return "NFKD"
+	}
+
+	return "NormalizationForm(" + strconv.Itoa(int(f)) + ")"
+}
+
+// IsValidUTF8 reports whether str is entirely valid UTF-8. Regular expressions
+// match invalid bytes as U+FFFD, so validators that accept arbitrary Unicode
+// should be combined with this check.
+func IsValidUTF8(str string) bool {
+	return utf8.ValidString(str)
+}
+
+// IsNFC reports whether str is valid UTF-8 in Normalization Form C.
+func IsNFC(str string) bool {
+	return IsNormalized(str, NFC)
+}
+
+// IsNFKC reports whether str is valid UTF-8 in Normalization Form KC.
+func IsNFKC(str string) bool {
+	return IsNormalized(str, NFKC)
+}
+
+// IsNormalized reports whether str is valid UTF-8 in the given normalization
+// form.
+func IsNormalized(str string, form NormalizationForm) bool {
+	return utf8.ValidString(str) && normalizationForms[form].IsNormalString(str)
+}
+
+// Normalize returns str in the given normalization form.
+func Normalize(str string, form NormalizationForm) string {
+	return normalizationForms[form].String(str)
+}
+
+// WithNormalization returns a validator that rejects invalid UTF-8 and runs fn
+// on the normalized input, so that a precomposed é and an e followed by a
+// combining acute accent are treated alike:
+//
+//	isName := validator.WithNormalization(validator.NFC, validator.IsAlphaUnicode)
+//	isName("é") // true
+func WithNormalization(form NormalizationForm, fn func(string) bool) func(string) bool {
+	return func(str string) bool {
+		return utf8.ValidString(str) && fn(Normalize(str, form))
+	}
+}
+
+// HasInvisibleChar reports whether str contains a character that is not
+// rendered on its own: format characters such as zero-width spaces and
+// joiners, bidi controls, soft hyphens and the other default-ignorable code
+// points such as Hangul fillers.
+func HasInvisibleChar(str string) bool {
+	return strings.IndexFunc(str, isInvisible) != -1
+}
+
+// HasBidiControl reports whether str contains a bidirectional formatting
+// character such as RIGHT-TO-LEFT OVERRIDE (U+202E), which can be used to
+// reorder how the rest of a line is displayed.
+func HasBidiControl(str string) bool {
+	return strings.IndexFunc(str, isBidiControl) != -1
+}
+
+// StripInvisibleChars removes the characters reported by HasInvisibleChar,
+// for example before displaying a user-provided name.
+func StripInvisibleChars(str string) string {
+	return strings.Map(func(r rune) rune {
+		if isInvisible(r) {
+			return -1
+		}
+		return r
+	}, str)
+}
+
+func isInvisible(r rune) bool {
+	return r >= 0x80 && (unicode.Is(unicode.Cf, r) || unicode.Is(unicode.Other_Default_Ignorable_Code_Point, r))
+}
+
+func isBidiControl(r rune) bool {
+	return r >= 0x80 && unicode.Is(unicode.Bidi_Control, r)
+}
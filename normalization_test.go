@@ -0,0 +1,134 @@
+package validator_test
+
+import (
+	validator "github.com/MrWormHole/simple-validator"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestIsValidUTF8(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []testCase{
+		{"", true},
+		{"abc", true},
+		{"héllo 世界", true},
+		{"\xff", false},
+		{"abc\xc3", false},
+		{"\xed\xa0\x80", false},
+	}
+
+	for _, t := range testCases {
+		actual := validator.IsValidUTF8(t.param)
+		assert.Equal(t.expected, actual, t.param)
+	}
+}
+
+func TestIsNFC(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []testCase{
+		{"", true},
+		{"abc", true},
+		{"é", true},
+		{"e\u0301", false},
+		{"ﬁ", true},
+		{"\xff", false},
+	}
+
+	for _, t := range testCases {
+		actual := validator.IsNFC(t.param)
+		assert.Equal(t.expected, actual, t.param)
+	}
+}
+
+func TestIsNFKC(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []testCase{
+		{"abc", true},
+		{"é", true},
+		{"e\u0301", false},
+		{"ﬁ", false},
+		{"Ａ", false},
+		{"\xff", false},
+	}
+
+	for _, t := range testCases {
+		actual := validator.IsNFKC(t.param)
+		assert.Equal(t.expected, actual, t.param)
+	}
+
+	assert.True(validator.IsNormalized("e\u0301", validator.NFD))
+	assert.False(validator.IsNormalized("é", validator.NFKD))
+	assert.Equal("fi", validator.Normalize("ﬁ", validator.NFKC))
+	assert.Equal("NFKC", validator.NFKC.String())
+}
+
+func TestWithNormalization(t *testing.T) {
+	assert := assert.New(t)
+
+	isSingleRune := func(str string) bool {
+		return validator.IsLength(str, 1, 1, validator.Runes)
+	}
+	assert.False(isSingleRune("e\u0301"))
+
+	isNFCSingleRune := validator.WithNormalization(validator.NFC, isSingleRune)
+	assert.True(isNFCSingleRune("e\u0301"))
+	assert.True(isNFCSingleRune("é"))
+	assert.False(isNFCSingleRune("\xff"))
+
+	isAlphaNumeric := validator.WithNormalization(validator.NFKC, validator.IsAlphaNumeric)
+	assert.True(isAlphaNumeric("Ａ１"))
+}
+
+func TestHasInvisibleChar(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []testCase{
+		{"", false},
+		{"plain name", false},
+		{"tab\there", false},
+		{"emoji 👍", false},
+		{"zero\u200bwidth", true},
+		{"join\u200der", true},
+		{"non\u200cjoiner", true},
+		{"soft\u00adhyphen", true},
+		{"\ufeffbom", true},
+		{"rlo\u202eevil", true},
+		{"hangul\u3164filler", true},
+		{"tag\U000E0041", true},
+	}
+
+	for _, t := range testCases {
+		actual := validator.HasInvisibleChar(t.param)
+		assert.Equal(t.expected, actual, t.param)
+	}
+}
+
+func TestHasBidiControl(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []testCase{
+		{"abc", false},
+		{"עברית", false},
+		{"zero\u200bwidth", false},
+		{"invoice\u202egnp.exe", true},
+		{"\u2066isolate\u2069", true},
+		{"mark\u200f", true},
+		{"\u061c", true},
+	}
+
+	for _, t := range testCases {
+		actual := validator.HasBidiControl(t.param)
+		assert.Equal(t.expected, actual, t.param)
+	}
+}
+
+func TestStripInvisibleChars(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("invoicegnp.exe", validator.StripInvisibleChars("invoice\u202egnp.exe"))
+	assert.Equal("admin", validator.StripInvisibleChars("ad\u200bmin\u200d"))
+	assert.Equal("tab\there", validator.StripInvisibleChars("tab\there"))
+}
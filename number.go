@@ -0,0 +1,141 @@
+package validator
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Number is the set of native integer and floating-point types accepted by the
+// range checks.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// maxBigExponent bounds the exponent accepted by the arbitrary-precision
+// checks so that inputs like 1e999999999 cannot force huge allocations.
+const maxBigExponent = 1 << 16
+
+// IsInt reports whether str is a base 10 integer that fits in a signed integer
+// of the given bit size (0 means int).
+func IsInt(str string, bitSize int) bool {
+	if !matchInteger(str) {
+		return false
+	}
+
+	_, err := strconv.ParseInt(str, 10, bitSize)
+	return err == nil
+}
+
+// IsUint reports whether str is a base 10 integer that fits in an unsigned
+// integer of the given bit size (0 means uint).
+func IsUint(str string, bitSize int) bool {
+	if !matchInteger(str) || str[0] == '-' {
+		return false
+	}
+
+	_, err := strconv.ParseUint(strings.TrimPrefix(str, "+"), 10, bitSize)
+	return err == nil
+}
+
+// IsFloat reports whether str is a decimal floating-point number, such as 1,
+// -1.5, .5, 1. or 6.02e23, whose magnitude fits in a float of the given bit
+// size (32 or 64).
+func IsFloat(str string, bitSize int) bool {
+	if !floatRegex.MatchString(str) {
+		return false
+	}
+
+	_, err := strconv.ParseFloat(str, bitSize)
+	return err == nil
+}
+
+// IsBigInt reports whether str is a base 10 integer of any size.
+func IsBigInt(str string) bool {
+	return matchInteger(str)
+}
+
+// IsBigFloat reports whether str is a decimal floating-point number of any
+// size and precision, with an exponent between -65536 and 65536 so that
+// hostile input such as 1e999999999 cannot exhaust memory.
+func IsBigFloat(str string) bool {
+	_, ok := parseBigRat(str)
+	return ok
+}
+
+// IsDecimal reports whether str fits a fixed-point column with the given
+// precision (total significant digits) and scale (digits after the decimal
+// point), as in SQL DECIMAL(precision, scale). Exponents are not accepted.
+func IsDecimal(str string, precision int, scale int) bool {
+	if !decimalRegex.MatchString(str) || scale < 0 || precision < scale {
+		return false
+	}
+
+	digits := strings.TrimLeft(str, "+-")
+	integer, fraction, _ := strings.Cut(digits, ".")
+	integer = strings.TrimLeft(integer, "0")
+	fraction = strings.TrimRight(fraction, "0")
+
+	return len(fraction) <= scale && len(integer) <= precision-scale
+}
+
+// IsNumericBetween reports whether str is a number (as accepted by IsBigFloat)
+// within the inclusive range [min, max]. The comparison is exact and works for
+// values beyond 64 bits.
+func IsNumericBetween(str string, min string, max string) bool {
+	return IsNumericMin(str, min) && IsNumericMax(str, max)
+}
+
+// IsNumericMin reports whether str is a number greater than or equal to min.
+func IsNumericMin(str string, min string) bool {
+	value, ok := parseBigRat(str)
+	if !ok {
+		return false
+	}
+	bound, ok := parseBigRat(min)
+
+	return ok && value.Cmp(bound) >= 0
+}
+
+// IsNumericMax reports whether str is a number less than or equal to max.
+func IsNumericMax(str string, max string) bool {
+	value, ok := parseBigRat(str)
+	if !ok {
+		return false
+	}
+	bound, ok := parseBigRat(max)
+
+	return ok && value.Cmp(bound) <= 0
+}
+
+func parseBigRat(str string) (*big.Rat, bool) {
+	if !floatRegex.MatchString(str) {
+		return nil, false
+	}
+
+	if i := strings.IndexAny(str, "eE"); i != -1 {
+		exponent, err := strconv.Atoi(str[i+1:])
+		if err != nil || exponent > maxBigExponent || exponent < -maxBigExponent {
+			return nil, false
+		}
+	}
+
+	return new(big.Rat).SetString(str)
+}
+
+// IsBetween reports whether v lies within the inclusive range [min, max].
+func IsBetween[T Number](v T, min T, max T) bool {
+	return v >= min && v <= max
+}
+
+// IsAtLeast reports whether v is greater than or equal to min.
+func IsAtLeast[T Number](v T, min T) bool {
+	return v >= min
+}
+
+// IsAtMost reports whether v is less than or equal to max.
+func IsAtMost[T Number](v T, max T) bool {
+	return v <= max
+}
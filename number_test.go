@@ -0,0 +1,204 @@
+package validator_test
+
+import (
+	validator "github.com/MrWormHole/simple-validator"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func TestIsInt(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []struct {
+		param    string
+		bitSize  int
+		expected bool
+	}{
+		{"0", 64, true},
+		{"-9223372036854775808", 64, true},
+		{"9223372036854775807", 64, true},
+		{"9223372036854775808", 64, false},
+		{"+127", 8, true},
+		{"128", 8, false},
+		{"-129", 8, false},
+		{"1.0", 64, false},
+		{"1e3", 64, false},
+		{"0x10", 64, false},
+		{"1_000", 64, false},
+		{"", 64, false},
+	}
+
+	for _, t := range testCases {
+		actual := validator.IsInt(t.param, t.bitSize)
+		assert.Equal(t.expected, actual, t.param)
+	}
+}
+
+func TestIsUint(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []struct {
+		param    string
+		bitSize  int
+		expected bool
+	}{
+		{"0", 64, true},
+		{"+255", 8, true},
+		{"18446744073709551615", 64, true},
+		{"18446744073709551616", 64, false},
+		{"256", 8, false},
+		{"-1", 64, false},
+		{"-0", 64, false},
+		{"", 64, false},
+	}
+
+	for _, t := range testCases {
+		actual := validator.IsUint(t.param, t.bitSize)
+		assert.Equal(t.expected, actual, t.param)
+	}
+}
+
+func TestIsFloat(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []struct {
+		param    string
+		bitSize  int
+		expected bool
+	}{
+		{"1", 64, true},
+		{"-1.5", 64, true},
+		{".5", 64, true},
+		{"1.", 64, true},
+		{"1e10", 64, true},
+		{"6.02E+23", 64, true},
+		{"1e-400", 64, true},
+		{"1e400", 64, false},
+		{"1e39", 32, false},
+		{"1e38", 32, true},
+		{"NaN", 64, false},
+		{"Inf", 64, false},
+		{"0x1p-2", 64, false},
+		{".", 64, false},
+		{"1e", 64, false},
+		{"e5", 64, false},
+		{"", 64, false},
+	}
+
+	for _, t := range testCases {
+		actual := validator.IsFloat(t.param, t.bitSize)
+		assert.Equal(t.expected, actual, t.param)
+	}
+}
+
+func TestIsBigInt(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []testCase{
+		{"123456789012345678901234567890", true},
+		{"-1", true},
+		{"1.0", false},
+		{"", false},
+	}
+
+	for _, t := range testCases {
+		actual := validator.IsBigInt(t.param)
+		assert.Equal(t.expected, actual, t.param)
+	}
+}
+
+func TestIsBigFloat(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []testCase{
+		{"1e400", true},
+		{"-.5", true},
+		{"1.", true},
+		{"1e65536", true},
+		{"1e-65536", true},
+		{"1e65537", false},
+		{"1e999999999", false},
+		{"1/3", false},
+		{"", false},
+	}
+
+	for _, t := range testCases {
+		actual := validator.IsBigFloat(t.param)
+		assert.Equal(t.expected, actual, t.param)
+	}
+}
+
+func TestIsDecimal(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []struct {
+		param     string
+		precision int
+		scale     int
+		expected  bool
+	}{
+		{"123.45", 5, 2, true},
+		{"-123.45", 5, 2, true},
+		{"0123.450", 5, 2, true},
+		{"1234.5", 5, 2, false},
+		{"12.345", 5, 2, false},
+		{"999", 5, 2, true},
+		{"1000", 5, 2, false},
+		{".99", 2, 2, true},
+		{"1e2", 5, 2, false},
+		{"", 5, 2, false},
+		{"1", 1, 2, false},
+	}
+
+	for _, t := range testCases {
+		actual := validator.IsDecimal(t.param, t.precision, t.scale)
+		assert.Equal(t.expected, actual, t.param)
+	}
+}
+
+func TestIsNumericBetween(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []struct {
+		param    string
+		min      string
+		max      string
+		expected bool
+	}{
+		{"5", "1", "10", true},
+		{"1", "1", "10", true},
+		{"10", "1", "10", true},
+		{"10.0000000000000000001", "1", "10", false},
+		{"0.999", "1", "10", false},
+		{"1e2", "0", "100", true},
+		{"18446744073709551616", "0", "18446744073709551615", false},
+		{"99999999999999999999999", "-1e30", "1e30", true},
+		{"abc", "1", "10", false},
+		{"5", "abc", "10", false},
+	}
+
+	for _, t := range testCases {
+		actual := validator.IsNumericBetween(t.param, t.min, t.max)
+		assert.Equal(t.expected, actual, t.param)
+	}
+
+	assert.True(validator.IsNumericMin("3.5", "3.5"))
+	assert.False(validator.IsNumericMin("3.4", "3.5"))
+	assert.True(validator.IsNumericMax("-1", "0"))
+	assert.False(validator.IsNumericMax("1", "0"))
+}
+
+func TestIsBetween(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.True(validator.IsBetween(5, 1, 10))
+	assert.False(validator.IsBetween(11, 1, 10))
+	assert.True(validator.IsBetween(uint8(255), 0, 255))
+	assert.True(validator.IsBetween(0.5, 0, 1))
+	assert.False(validator.IsBetween(math.NaN(), 0, 1))
+	assert.True(validator.IsAtLeast(int64(math.MaxInt64), 0))
+	assert.False(validator.IsAtLeast(-1, 0))
+	assert.True(validator.IsAtMost(float32(1.5), 2))
+	assert.False(validator.IsAtMost(3, 2))
+}
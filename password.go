@@ -0,0 +1,466 @@
+package validator
+
+import (
+	_ "embed"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// PasswordStrength is a zxcvbn-style strength score from 0 to 4.
+type PasswordStrength int
+
+const (
+	// PasswordVeryWeak passwords take fewer than 10^3 guesses.
+	PasswordVeryWeak PasswordStrength = iota
+	// PasswordWeak passwords take fewer than 10^6 guesses.
+	PasswordWeak
+	// PasswordFair passwords take fewer than 10^8 guesses.
+	PasswordFair
+	// PasswordStrong passwords take fewer than 10^10 guesses.
+	PasswordStrong
+	// PasswordVeryStrong passwords take 10^10 guesses or more.
+	PasswordVeryStrong
+)
+
+func (s PasswordStrength) String() string {
+	switch s {
+	case PasswordVeryWeak:
+		return "very weak"
+	case PasswordWeak:
+		return "weak"
+	case PasswordFair:
+		return "fair"
+	case PasswordStrong:
+		return "strong"
+	case PasswordVeryStrong:
+		return "very strong"
+	}
+
+	return "PasswordStrength(" + strconv.Itoa(int(s)) + ")"
+}
+
+// PasswordPolicy describes the rules a password must follow. Zero values
+// disable the corresponding rule.
+type PasswordPolicy struct {
+	// MinLength and MaxLength bound the length in runes.
+	MinLength int
+	MaxLength int
+
+	RequireUpper  bool
+	RequireLower  bool
+	RequireDigit  bool
+	RequireSymbol bool
+
+	// MaxRepeated is the longest allowed run of the same character, so 2
+	// rejects aaa.
+	MaxRepeated int
+	// MaxSequential is the longest allowed run of consecutive characters
+	// (abc, 987) or neighbouring keys (qwerty, asdf), so 3 rejects abcd.
+	MaxSequential int
+
+	// Forbidden lists strings, such as the username or email address, that
+	// must not appear in the password. Matching is case-insensitive and the
+	// local part of an email address is checked on its own as well.
+	Forbidden []string
+
+	// RejectCommon rejects passwords found in the embedded list of common
+	// passwords.
+	RejectCommon bool
+	// MinStrength is the minimum estimated strength. Forbidden strings are
+	// taken into account by the estimate.
+	MinStrength PasswordStrength
+}
+
+// PasswordViolation is a rule that a password does not satisfy. Rule is a
+// stable identifier and Message is feedback suitable for the user.
+type PasswordViolation struct {
+	Rule    string
+	Message string
+}
+
+func (v PasswordViolation) String() string {
+	return v.Message
+}
+
+// PasswordEstimate is the result of EstimatePassword.
+type PasswordEstimate struct {
+	// Guesses is the estimated number of guesses needed to find the password.
+	Guesses float64
+	// Entropy is log2(Guesses) in bits.
+	Entropy  float64
+	Strength PasswordStrength
+}
+
+var (
+	//go:embed data/passwords.txt
+	commonPasswordsData string
+
+	// commonPasswords maps lower-case common passwords to their rank, 1 being
+	// the most common, parsed from the embedded data on first use.
+	commonPasswords          = newLazyTable(parseCommonPasswords)
+	keyboardRows             = []string{"`1234567890-=", "qwertyuiop[]\\", "asdfghjkl;'", "zxcvbnm,./", "741", "852", "963"}
+	passwordLeetSubstitution = strings.NewReplacer("4", "a", "@", "a", "8", "b", "3", "e", "6", "g", "1", "i", "!", "i", "0", "o", "$", "s", "5", "s", "7", "t", "+", "t", "2", "z")
+)
+
+type commonPasswordTable struct {
+	ranks   map[string]int
+	longest int
+}
+
+func parseCommonPasswords() commonPasswordTable {
+	passwords := commonPasswordTable{ranks: map[string]int{}}
+	rank := 0
+	eachRecord(commonPasswordsData, func(fields []string) {
+		rank++
+		passwords.ranks[fields[0]] = rank
+		if len(fields[0]) > passwords.longest {
+			passwords.longest = len(fields[0])
+		}
+	})
+
+	return passwords
+}
+
+// IsPassword reports whether password satisfies every rule of the policy.
+func IsPassword(password string, policy PasswordPolicy) bool {
+	return len(CheckPassword(password, policy)) == 0
+}
+
+// CheckPassword returns the rules of the policy that password violates, in
+// the order they are declared in PasswordPolicy. MinStrength is not checked
+// for a password longer than MaxLength.
+func CheckPassword(password string, policy PasswordPolicy) []PasswordViolation {
+	var violations []PasswordViolation
+	add := func(rule string, format string, args ...interface{}) {
+		violations = append(violations, PasswordViolation{Rule: rule, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if !utf8.ValidString(password) {
+		add("encoding", "password must be valid UTF-8")
+		return violations
+	}
+
+	length := utf8.RuneCountInString(password)
+	if length < policy.MinLength {
+		add("min-length", "password must be at least %d characters long", policy.MinLength)
+	}
+	tooLong := policy.MaxLength > 0 && length > policy.MaxLength
+	if tooLong {
+		add("max-length", "password must be at most %d characters long", policy.MaxLength)
+	}
+
+	var upper, lower, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case !unicode.IsLetter(r):
+			symbol = true
+		}
+	}
+	if policy.RequireUpper && !upper {
+		add("upper", "password must contain an uppercase letter")
+	}
+	if policy.RequireLower && !lower {
+		add("lower", "password must contain a lowercase letter")
+	}
+	if policy.RequireDigit && !digit {
+		add("digit", "password must contain a digit")
+	}
+	if policy.RequireSymbol && !symbol {
+		add("symbol", "password must contain a symbol")
+	}
+
+	if policy.MaxRepeated > 0 && longestRepeat(password) > policy.MaxRepeated {
+		add("repeated", "password must not repeat a character more than %d times in a row", policy.MaxRepeated)
+	}
+	if policy.MaxSequential > 0 && longestSequence(password) > policy.MaxSequential {
+		add("sequential", "password must not contain sequences such as abcd, 4321 or qwerty longer than %d characters", policy.MaxSequential)
+	}
+
+	lowerPassword := strings.ToLower(password)
+	for _, forbidden := range forbiddenPasswordInputs(policy.Forbidden) {
+		if strings.Contains(lowerPassword, forbidden) {
+			add("forbidden", "password must not contain %q", forbidden)
+		}
+	}
+
+	if policy.RejectCommon {
+		if _, common := commonPasswords.get().ranks[lowerPassword]; common {
+			add("common", "password is too common")
+		}
+	}
+
+	// The strength of a password that is too long is not estimated.
+	if policy.MinStrength > PasswordVeryWeak && !tooLong {
+		if estimate := EstimatePassword(password, policy.Forbidden...); estimate.Strength < policy.MinStrength {
+			add("strength", "password is %s, it must be at least %s", estimate.Strength, policy.MinStrength)
+		}
+	}
+
+	return violations
+}
+
+// EstimatePassword estimates how many guesses an attacker needs to find
+// password, in the spirit of zxcvbn: the password is split into the cheapest
+// sequence of common passwords (also in l33t speak or with capitals), user
+// inputs, repeated characters, sequences, keyboard patterns and years, with
+// the remaining characters brute-forced. userInputs, such as the username,
+// are treated as the most common passwords.
+//
+// Patterns are only searched for in the first 256 characters of password, and
+// are at most 64 characters long, so user inputs longer than that are never
+// matched; the characters after the first 256 are brute-forced.
+func EstimatePassword(password string, userInputs ...string) PasswordEstimate {
+	runes := []rune(password)
+	inputs := forbiddenPasswordInputs(userInputs)
+
+	brute := 0
+	if len(runes) > maxEstimatedPasswordLength {
+		brute = len(runes) - maxEstimatedPasswordLength
+		runes = runes[:maxEstimatedPasswordLength]
+	}
+
+	// best[i] is the lowest log2 number of guesses for the first i runes.
+	best := make([]float64, len(runes)+1)
+	for end := 1; end <= len(runes); end++ {
+		best[end] = best[end-1] + math.Log2(bruteforceCardinality)
+		for start := end - 2; start >= 0 && end-start <= maxPasswordTokenLength; start-- {
+			if guesses := matchGuesses(runes[start:end], inputs); guesses > 0 {
+				if cost := best[start] + math.Log2(guesses); cost < best[end] {
+					best[end] = cost
+				}
+			}
+		}
+	}
+
+	entropy := best[len(runes)] + float64(brute)*math.Log2(bruteforceCardinality)
+	estimate := PasswordEstimate{Guesses: math.Pow(2, entropy), Entropy: entropy}
+	switch {
+	case estimate.Guesses < 1e3:
+		estimate.Strength = PasswordVeryWeak
+	case estimate.Guesses < 1e6:
+		estimate.Strength = PasswordWeak
+	case estimate.Guesses < 1e8:
+		estimate.Strength = PasswordFair
+	case estimate.Guesses < 1e10:
+		estimate.Strength = PasswordStrong
+	default:
+		estimate.Strength = PasswordVeryStrong
+	}
+
+	return estimate
+}
+
+const (
+	bruteforceCardinality = 10
+	minMatchGuesses       = 50
+	// maxPasswordTokenLength bounds the patterns searched for by
+	// EstimatePassword so that long inputs are not quadratic in cost.
+	maxPasswordTokenLength = 64
+	// maxEstimatedPasswordLength bounds the prefix of a password searched for
+	// patterns, so that the cost of EstimatePassword is linear.
+	maxEstimatedPasswordLength = 256
+)
+
+// matchGuesses returns the number of guesses needed for token if it matches
+// one of the known patterns, or 0 otherwise.
+func matchGuesses(token []rune, userInputs []string) float64 {
+	if len(token) < 2 {
+		return 0
+	}
+
+	str := string(token)
+	lower := strings.ToLower(str)
+	guesses := 0.0
+	consider := func(g float64) {
+		if g > 0 && g < minMatchGuesses {
+			g = minMatchGuesses
+		}
+		if g > 0 && (guesses == 0 || g < guesses) {
+			guesses = g
+		}
+	}
+
+	for _, input := range userInputs {
+		if lower == input {
+			consider(uppercaseVariations(str))
+		}
+	}
+	if passwords := commonPasswords.get(); len(lower) <= passwords.longest {
+		if rank, ok := passwords.ranks[lower]; ok {
+			consider(float64(rank) * uppercaseVariations(str))
+		} else if rank, ok := passwords.ranks[passwordLeetSubstitution.Replace(lower)]; ok {
+			consider(float64(rank) * uppercaseVariations(str) * 2)
+		}
+	}
+
+	if longestRepeat(str) == len(token) {
+		consider(float64(runeCardinality(token[0]) * len(token)))
+	}
+	if len(token) >= 3 && isAlphabeticalSequence(token) {
+		base := 26.0
+		switch {
+		case strings.ContainsRune("aAzZ019", token[0]):
+			base = 4
+		case unicode.IsDigit(token[0]):
+			base = 10
+		}
+		consider(base * float64(len(token)) * 2)
+	}
+	if len(token) >= 4 && isKeyboardSequence(lower) {
+		consider(float64(40 * len(token)))
+	}
+	if len(token) == 4 && isASCIIDigits(str) {
+		if year, _ := strconv.Atoi(str); year >= 1900 && year <= 2099 {
+			consider(math.Max(math.Abs(float64(year-2020)), 20))
+		}
+	}
+
+	return guesses
+}
+
+// uppercaseVariations returns the factor by which capitalization multiplies
+// the guesses for a dictionary word.
+func uppercaseVariations(str string) float64 {
+	upper, lower := 0, 0
+	for _, r := range str {
+		switch {
+		case unicode.IsUpper(r):
+			upper++
+		case unicode.IsLower(r):
+			lower++
+		}
+	}
+
+	first, _ := utf8.DecodeRuneInString(str)
+	switch {
+	case upper == 0:
+		return 1
+	case lower == 0, upper == 1 && unicode.IsUpper(first):
+		return 2
+	}
+
+	return math.Pow(2, float64(upper+lower)) / 2
+}
+
+func runeCardinality(r rune) int {
+	switch {
+	case r >= '0' && r <= '9':
+		return 10
+	case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+		return 26
+	case r < utf8.RuneSelf:
+		return 33
+	}
+
+	return 100
+}
+
+func forbiddenPasswordInputs(inputs []string) []string {
+	var forbidden []string
+	for _, input := range inputs {
+		input = strings.ToLower(strings.TrimSpace(input))
+		if input == "" {
+			continue
+		}
+		forbidden = append(forbidden, input)
+		if local, _, isEmail := strings.Cut(input, "@"); isEmail && local != "" {
+			forbidden = append(forbidden, local)
+		}
+	}
+
+	return forbidden
+}
+
+func longestRepeat(str string) int {
+	longest, current := 0, 0
+	var previous rune = -1
+	for _, r := range str {
+		if r == previous {
+			current++
+		} else {
+			current = 1
+		}
+		if current > longest {
+			longest = current
+		}
+		previous = r
+	}
+
+	return longest
+}
+
+// longestSequence returns the length of the longest alphabetical or keyboard
+// sequence in str.
+func longestSequence(str string) int {
+	runes := []rune(strings.ToLower(str))
+	longest := 0
+	if len(runes) > 0 {
+		longest = 1
+	}
+
+	for start := 0; start < len(runes); start++ {
+		for end := start + longest + 1; end <= len(runes); end++ {
+			token := runes[start:end]
+			if !isAlphabeticalSequence(token) && !isKeyboardSequence(string(token)) {
+				break
+			}
+			longest = end - start
+		}
+	}
+
+	return longest
+}
+
+// isAlphabeticalSequence reports whether token is a run of letters or digits
+// whose code points go up or down by one, such as abc or 321.
+func isAlphabeticalSequence(token []rune) bool {
+	if len(token) < 2 {
+		return false
+	}
+
+	step := token[1] - token[0]
+	if step != 1 && step != -1 {
+		return false
+	}
+	for i, r := range token {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			return false
+		}
+		if i > 0 && r-token[i-1] != step {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isKeyboardSequence reports whether the lower-case token is a run of
+// neighbouring keys on a QWERTY keyboard row or numeric keypad, forwards or
+// backwards.
+func isKeyboardSequence(token string) bool {
+	if utf8.RuneCountInString(token) < 2 {
+		return false
+	}
+
+	reversed := []rune(token)
+	for i, j := 0, len(reversed)-1; i < j; i, j = i+1, j-1 {
+		reversed[i], reversed[j] = reversed[j], reversed[i]
+	}
+	for _, row := range keyboardRows {
+		if strings.Contains(row, token) || strings.Contains(row, string(reversed)) {
+			return true
+		}
+	}
+
+	return false
+}
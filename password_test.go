@@ -0,0 +1,131 @@
+package validator_test
+
+import (
+	validator "github.com/MrWormHole/simple-validator"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestIsPassword(t *testing.T) {
+	assert := assert.New(t)
+
+	policy := validator.PasswordPolicy{
+		MinLength:     10,
+		MaxLength:     64,
+		RequireUpper:  true,
+		RequireLower:  true,
+		RequireDigit:  true,
+		RequireSymbol: true,
+		MaxRepeated:   2,
+		MaxSequential: 3,
+		Forbidden:     []string{"jdoe", "John.Doe@example.com"},
+		RejectCommon:  true,
+		MinStrength:   validator.PasswordStrong,
+	}
+
+	testCases := []testCase{
+		{"Vq7#mK2!xLp9", true},
+		{"correct-Horse-7-battery", true},
+		{"Sh0rt!a", false},
+		{"alllowercase-7!", false},
+		{"ALLUPPERCASE-7!", false},
+		{"NoDigitsHere!!x", false},
+		{"NoSymbols1234x", false},
+		{"Vq7#mK2!xLppp9", false},
+		{"Vq7#abcd!xLp9", false},
+		{"Vq7#qwer!xLp9", false},
+		{"Vq7#4321!xLp9", false},
+		{"Vq7#JDoe!xLp9", false},
+		{"Vq7#john.doe!9", false},
+		{"P@ssw0rd2023!", false},
+		{"Vq7#mK2!xLp9\xff", false},
+	}
+
+	for _, t := range testCases {
+		actual := validator.IsPassword(t.param, policy)
+		assert.Equal(t.expected, actual, t.param)
+	}
+
+	assert.True(validator.IsPassword("anything", validator.PasswordPolicy{}))
+}
+
+func TestCheckPassword(t *testing.T) {
+	assert := assert.New(t)
+
+	policy := validator.PasswordPolicy{
+		MinLength:     12,
+		RequireUpper:  true,
+		RequireDigit:  true,
+		MaxRepeated:   2,
+		MaxSequential: 3,
+		Forbidden:     []string{"alice@example.com"},
+		RejectCommon:  true,
+		MinStrength:   validator.PasswordFair,
+	}
+
+	violations := validator.CheckPassword("alice1111", policy)
+	var rules []string
+	for _, violation := range violations {
+		rules = append(rules, violation.Rule)
+	}
+	assert.Equal([]string{"min-length", "upper", "repeated", "forbidden", "strength"}, rules)
+	assert.Equal("password must be at least 12 characters long", violations[0].String())
+	assert.Equal(`password must not contain "alice"`, violations[3].Message)
+	assert.Equal("password is weak, it must be at least fair", violations[4].Message)
+
+	violations = validator.CheckPassword("password", validator.PasswordPolicy{RejectCommon: true})
+	assert.Len(violations, 1)
+	assert.Equal("common", violations[0].Rule)
+
+	violations = validator.CheckPassword("abcdef", validator.PasswordPolicy{MaxSequential: 3})
+	assert.Equal("password must not contain sequences such as abcd, 4321 or qwerty longer than 3 characters", violations[0].Message)
+
+	assert.Empty(validator.CheckPassword("abc!xyz", validator.PasswordPolicy{MaxSequential: 3, MaxRepeated: 1}))
+
+	violations = validator.CheckPassword(strings.Repeat("a", 100), validator.PasswordPolicy{MaxLength: 64, MinStrength: validator.PasswordFair})
+	assert.Len(violations, 1)
+	assert.Equal("max-length", violations[0].Rule)
+}
+
+func TestEstimatePassword(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []struct {
+		param    string
+		expected validator.PasswordStrength
+	}{
+		{"", validator.PasswordVeryWeak},
+		{"password", validator.PasswordVeryWeak},
+		{"Password", validator.PasswordVeryWeak},
+		{"p@ssw0rd", validator.PasswordVeryWeak},
+		{"123456789", validator.PasswordVeryWeak},
+		{"aaaaaaaaaaaa", validator.PasswordVeryWeak},
+		{"qwertyuiop", validator.PasswordVeryWeak},
+		{"abcdefgh", validator.PasswordVeryWeak},
+		{"dragon1987", validator.PasswordWeak},
+		{"monkeyshadow", validator.PasswordWeak},
+		{"Vq7#mK2!", validator.PasswordFair},
+		{"Vq7#mK2!xL", validator.PasswordVeryStrong},
+		{"correct horse battery staple", validator.PasswordVeryStrong},
+	}
+
+	for _, t := range testCases {
+		actual := validator.EstimatePassword(t.param)
+		assert.Equal(t.expected, actual.Strength, t.param)
+	}
+
+	estimate := validator.EstimatePassword("Vq7#mK2!xL")
+	assert.InDelta(33.2, estimate.Entropy, 0.1)
+	assert.InDelta(1e10, estimate.Guesses, 1e6)
+
+	assert.Greater(validator.EstimatePassword("zorblax42x").Entropy, validator.EstimatePassword("zorblax42x", "Zorblax").Entropy)
+
+	// Patterns are searched for in the first 256 characters only.
+	long := validator.EstimatePassword(strings.Repeat("a", 10000))
+	assert.InDelta(validator.EstimatePassword(strings.Repeat("a", 256)).Entropy+(10000-256)*math.Log2(10), long.Entropy, 1e-6)
+	input := strings.Repeat("xq", 40)
+	assert.Equal(validator.EstimatePassword(input).Entropy, validator.EstimatePassword(input, input).Entropy)
+	assert.Equal("very strong", validator.PasswordVeryStrong.String())
+}
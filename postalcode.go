@@ -0,0 +1,214 @@
+package validator
+
+import (
+	"sort"
+	"strings"
+)
+
+// postalCodeRegexStrings maps ISO 3166-1 alpha-2 country codes to the pattern
+// a normalized (trimmed, single-spaced, upper-cased) postal code must match.
+var postalCodeRegexStrings = map[string]string{
+	"AD": `^AD ?[1-7]0\d$`,
+	"AM": `^\d{4}$`,
+	"AR": `^(?:[A-HJ-NP-Z]?\d{4}(?:[A-Z]{3})?)$`,
+	"AT": `^\d{4}$`,
+	"AU": `^\d{4}$`,
+	"AX": `^22\d{3}$`,
+	"AZ": `^(?:AZ ?)?\d{4}$`,
+	"BA": `^\d{5}$`,
+	"BD": `^\d{4}$`,
+	"BE": `^\d{4}$`,
+	"BG": `^\d{4}$`,
+	"BH": `^(?:1|2)?\d{3}$`,
+	"BN": `^[A-Z]{2} ?\d{4}$`,
+	"BR": `^\d{5}-?\d{3}$`,
+	"BY": `^\d{6}$`,
+	"CA": `^[ABCEGHJ-NPRSTVXY]\d[ABCEGHJ-NPRSTV-Z] ?\d[ABCEGHJ-NPRSTV-Z]\d$`,
+	"CH": `^\d{4}$`,
+	"CL": `^\d{3}-?\d{4}$`,
+	"CN": `^\d{6}$`,
+	"CO": `^\d{6}$`,
+	"CR": `^\d{5}$`,
+	"CU": `^\d{5}$`,
+	"CY": `^\d{4}$`,
+	"CZ": `^\d{3} ?\d{2}$`,
+	"DE": `^\d{5}$`,
+	"DK": `^\d{4}$`,
+	"DO": `^\d{5}$`,
+	"DZ": `^\d{5}$`,
+	"EC": `^\d{6}$`,
+	"EE": `^\d{5}$`,
+	"EG": `^\d{5}$`,
+	"ES": `^(?:5[0-2]|[0-4]\d)\d{3}$`,
+	"FI": `^\d{5}$`,
+	"FO": `^\d{3}$`,
+	"FR": `^\d{2} ?\d{3}$`,
+	"GB": `^(?:GIR ?0AA|[A-PR-UWYZ](?:\d{1,2}|[A-HK-Y]\d{1,2}|\d[A-HJKSTUW]|[A-HK-Y]\d[ABEHMNPRV-Y]) ?\d[ABD-HJLNP-UW-Z]{2})$`,
+	"GE": `^\d{4}$`,
+	"GF": `^9[78]3\d{2}$`,
+	"GG": `^GY\d[\dA-Z]? ?\d[ABD-HJLN-UW-Z]{2}$`,
+	"GL": `^39\d{2}$`,
+	"GP": `^9[78][01]\d{2}$`,
+	"GR": `^\d{3} ?\d{2}$`,
+	"GT": `^\d{5}$`,
+	"GU": `^969\d{2}(?:[ -]\d{4})?$`,
+	"HR": `^\d{5}$`,
+	"HT": `^\d{4}$`,
+	"HU": `^\d{4}$`,
+	"ID": `^\d{5}$`,
+	"IE": `^(?:[AC-FHKNPRTV-Y]\d{2}|D6W) ?[\dAC-FHKNPRTV-Y]{4}$`,
+	"IL": `^\d{5}(?:\d{2})?$`,
+	"IM": `^IM\d[\dA-Z]? ?\d[ABD-HJLN-UW-Z]{2}$`,
+	"IN": `^[1-9]\d{2} ?\d{3}$`,
+	"IQ": `^\d{5}$`,
+	"IR": `^\d{5}-?\d{5}$`,
+	"IS": `^\d{3}$`,
+	"IT": `^\d{5}$`,
+	"JE": `^JE\d[\dA-Z]? ?\d[ABD-HJLN-UW-Z]{2}$`,
+	"JO": `^\d{5}$`,
+	"JP": `^\d{3}-?\d{4}$`,
+	"KE": `^\d{5}$`,
+	"KG": `^\d{6}$`,
+	"KH": `^\d{5,6}$`,
+	"KR": `^\d{3}(?:\d{2}|-\d{3})$`,
+	"KW": `^\d{5}$`,
+	"KZ": `^\d{6}$`,
+	"LA": `^\d{5}$`,
+	"LB": `^\d{4}(?: ?\d{4})?$`,
+	"LI": `^94(?:8[5-9]|9[0-7])$`,
+	"LK": `^\d{5}$`,
+	"LT": `^(?:LT-)?\d{5}$`,
+	"LU": `^(?:L-)?\d{4}$`,
+	"LV": `^(?:LV-)?\d{4}$`,
+	"MA": `^\d{5}$`,
+	"MC": `^980\d{2}$`,
+	"MD": `^(?:MD-?)?\d{4}$`,
+	"ME": `^8\d{4}$`,
+	"MK": `^\d{4}$`,
+	"MN": `^\d{5}$`,
+	"MQ": `^9[78]2\d{2}$`,
+	"MT": `^[A-Z]{3} ?\d{2,4}$`,
+	"MX": `^\d{5}$`,
+	"MY": `^\d{5}$`,
+	"NC": `^988\d{2}$`,
+	"NG": `^\d{6}$`,
+	"NL": `^[1-9]\d{3} ?[A-Z]{2}$`,
+	"NO": `^\d{4}$`,
+	"NP": `^\d{5}$`,
+	"NZ": `^\d{4}$`,
+	"OM": `^(?:PC ?)?\d{3}$`,
+	"PE": `^\d{5}$`,
+	"PH": `^\d{4}$`,
+	"PK": `^\d{5}$`,
+	"PL": `^\d{2}-\d{3}$`,
+	"PR": `^00[679]\d{2}(?:[ -]\d{4})?$`,
+	"PT": `^\d{4}-\d{3}$`,
+	"PY": `^\d{4}$`,
+	"RE": `^9[78]4\d{2}$`,
+	"RO": `^\d{6}$`,
+	"RS": `^\d{5}$`,
+	"RU": `^\d{6}$`,
+	"SA": `^\d{5}(?:-\d{4})?$`,
+	"SE": `^\d{3} ?\d{2}$`,
+	"SG": `^\d{6}$`,
+	"SI": `^(?:SI-)?\d{4}$`,
+	"SK": `^\d{3} ?\d{2}$`,
+	"SM": `^4789\d$`,
+	"SN": `^\d{5}$`,
+	"TH": `^\d{5}$`,
+	"TJ": `^\d{6}$`,
+	"TM": `^\d{6}$`,
+	"TN": `^\d{4}$`,
+	"TR": `^\d{5}$`,
+	"TW": `^\d{3}(?:\d{2,3})?$`,
+	"UA": `^\d{5}$`,
+	"US": `^\d{5}(?:[ -]\d{4})?$`,
+	"UY": `^\d{5}$`,
+	"UZ": `^\d{6}$`,
+	"VA": `^00120$`,
+	"VE": `^\d{4}$`,
+	"VN": `^\d{5,6}$`,
+	"ZA": `^\d{4}$`,
+	"ZM": `^\d{5}$`,
+}
+
+// countriesWithoutPostalCode lists ISO 3166-1 alpha-2 codes of countries that
+// have no postal code system in use.
+var countriesWithoutPostalCode = map[string]struct{}{
+	"AE": {}, "AG": {}, "AO": {}, "AW": {}, "BF": {}, "BI": {}, "BJ": {}, "BO": {},
+	"BS": {}, "BW": {}, "BZ": {}, "CD": {}, "CF": {}, "CG": {}, "CI": {}, "CK": {},
+	"CM": {}, "DJ": {}, "DM": {}, "ER": {}, "FJ": {}, "GA": {}, "GD": {}, "GH": {},
+	"GM": {}, "GQ": {}, "GY": {}, "HK": {}, "KI": {}, "KM": {}, "KN": {}, "KP": {},
+	"ML": {}, "MO": {}, "MR": {}, "MW": {}, "NR": {}, "NU": {}, "QA": {}, "RW": {},
+	"SB": {}, "SC": {}, "SL": {}, "SR": {}, "SS": {}, "ST": {}, "SY": {}, "TD": {},
+	"TF": {}, "TG": {}, "TK": {}, "TL": {}, "TO": {}, "TV": {}, "UG": {}, "VU": {},
+	"YE": {}, "ZW": {},
+}
+
+// postalCodeRegexes maps countries to their pattern, which are registered on
+// first use.
+var postalCodeRegexes = newLazyTable(compilePostalCodeRegexes)
+
+func compilePostalCodeRegexes() map[string]*lazyRegexp {
+	regexes := make(map[string]*lazyRegexp, len(postalCodeRegexStrings))
+	for country, pattern := range postalCodeRegexStrings {
+		regexes[country] = lazyCompile(pattern)
+	}
+
+	return regexes
+}
+
+// IsPostalCode reports whether code is a valid postal code for the given
+// ISO 3166-1 alpha-2 country. Surrounding whitespace is ignored, inner
+// whitespace runs are collapsed to a single space and letters are compared
+// case-insensitively. Unknown countries and countries without a postal code
+// system always yield false.
+func IsPostalCode(code string, country string) bool {
+	regex, ok := postalCodeRegexes.get()[strings.ToUpper(strings.TrimSpace(country))]
+	if !ok {
+		return false
+	}
+
+	return regex.MatchString(NormalizePostalCode(code))
+}
+
+// NormalizePostalCode trims code, collapses inner whitespace to single spaces
+// and upper-cases it.
+func NormalizePostalCode(code string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(code), " "))
+}
+
+// HasPostalCodeSystem reports whether country is an ISO 3166-1 alpha-2 code,
+// compared case-insensitively, of a country that is not known to have no
+// postal code system. Unknown codes yield false. A true result does not mean
+// that IsPostalCode can succeed, as some of these countries have no pattern;
+// use SupportsPostalCode to check that.
+func HasPostalCodeSystem(country string) bool {
+	country = strings.ToUpper(strings.TrimSpace(country))
+	if !IsISO3166Alpha2(country) {
+		return false
+	}
+
+	_, ok := countriesWithoutPostalCode[country]
+	return !ok
+}
+
+// SupportsPostalCode reports whether IsPostalCode has a pattern for country,
+// compared case-insensitively. IsPostalCode always yields false for the
+// other countries.
+func SupportsPostalCode(country string) bool {
+	_, ok := postalCodeRegexStrings[strings.ToUpper(strings.TrimSpace(country))]
+	return ok
+}
+
+// CountriesWithoutPostalCode returns the sorted ISO 3166-1 alpha-2 codes of
+// countries that have no postal code system.
+func CountriesWithoutPostalCode() []string {
+	countries := make([]string, 0, len(countriesWithoutPostalCode))
+	for country := range countriesWithoutPostalCode {
+		countries = append(countries, country)
+	}
+	sort.Strings(countries)
+
+	return countries
+}
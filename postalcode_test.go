@@ -0,0 +1,93 @@
+package validator_test
+
+import (
+	validator "github.com/MrWormHole/simple-validator"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestIsPostalCode(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []struct {
+		code     string
+		country  string
+		expected bool
+	}{
+		{"SW1A 1AA", "GB", true},
+		{"sw1a1aa", "gb", true},
+		{"EC1A 1BB", "GB", true},
+		{"M1 1AE", "GB", true},
+		{"GIR 0AA", "GB", true},
+		{"QW1 1AA", "GB", false},
+		{"K1A 0B1", "CA", true},
+		{"k1a0b1", "CA", true},
+		{"D1A 0B1", "CA", false},
+		{"12345", "US", true},
+		{"12345-6789", "US", true},
+		{"12345 6789", "US", true},
+		{"1234", "US", false},
+		{"1234 AB", "NL", true},
+		{"1234ab", "NL", true},
+		{"  1234   ab ", "NL", true},
+		{"0234 AB", "NL", false},
+		{"10115", "DE", true},
+		{"1011", "DE", false},
+		{"100-0001", "JP", true},
+		{"00-950", "PL", true},
+		{"1000-001", "PT", true},
+		{"D02 X285", "IE", true},
+		{"75008", "FR", true},
+		{"12345", "AE", false},
+		{"", "AE", false},
+		{"12345", "XX", false},
+		{"", "US", false},
+	}
+
+	for _, t := range testCases {
+		actual := validator.IsPostalCode(t.code, t.country)
+		assert.Equal(t.expected, actual, "%q %q", t.code, t.country)
+	}
+}
+
+func TestNormalizePostalCode(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("SW1A 1AA", validator.NormalizePostalCode("  sw1a \t 1aa "))
+	assert.Equal("", validator.NormalizePostalCode("   "))
+}
+
+func TestHasPostalCodeSystem(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.True(validator.HasPostalCodeSystem("GB"))
+	assert.False(validator.HasPostalCodeSystem("ae"))
+	assert.False(validator.HasPostalCodeSystem("HK"))
+	assert.True(validator.HasPostalCodeSystem(" de "))
+	assert.False(validator.HasPostalCodeSystem("ZZ"))
+	assert.False(validator.HasPostalCodeSystem("GBR"))
+	assert.False(validator.HasPostalCodeSystem(""))
+}
+
+func TestSupportsPostalCode(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.True(validator.SupportsPostalCode("DE"))
+	assert.True(validator.SupportsPostalCode(" gb "))
+	assert.True(validator.HasPostalCodeSystem("PA"))
+	assert.False(validator.SupportsPostalCode("PA"))
+	assert.False(validator.IsPostalCode("0801", "PA"))
+	assert.False(validator.SupportsPostalCode("HK"))
+	assert.False(validator.SupportsPostalCode("ZZ"))
+	assert.False(validator.SupportsPostalCode(""))
+}
+
+func TestCountriesWithoutPostalCode(t *testing.T) {
+	assert := assert.New(t)
+
+	countries := validator.CountriesWithoutPostalCode()
+	assert.Contains(countries, "AE")
+	assert.Contains(countries, "HK")
+	assert.NotContains(countries, "GB")
+	assert.IsNonDecreasing(countries)
+}
@@ -0,0 +1,189 @@
+package validator
+
+import (
+	"os"
+	"regexp/syntax"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// maxAdversarialChars bounds the characters taken from patterns with large
+// classes such as \p{L}.
+const maxAdversarialChars = 12
+
+// adversarialInputs returns inputs of about n bytes built from the characters
+// that pattern mentions: each character repeated, pairs of them repeated, and
+// all of them cycled, each followed by a byte that makes the match fail at the
+// very end, which is the worst case for a backtracking engine.
+func adversarialInputs(t testing.TB, pattern string, n int) []string {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		t.Fatalf("parse %q: %v", pattern, err)
+	}
+
+	seen := map[rune]bool{}
+	var chars []string
+	var collect func(re *syntax.Regexp)
+	collect = func(re *syntax.Regexp) {
+		var runes []rune
+		switch re.Op {
+		case syntax.OpLiteral:
+			runes = re.Rune
+		case syntax.OpCharClass:
+			for i := 0; i < len(re.Rune); i += 2 {
+				runes = append(runes, re.Rune[i], re.Rune[i+1])
+			}
+		}
+		for _, r := range runes {
+			if r <= 0x10ffff && !seen[r] {
+				seen[r] = true
+				chars = append(chars, string(r))
+			}
+		}
+		for _, sub := range re.Sub {
+			collect(sub)
+		}
+	}
+	collect(re)
+	if len(chars) > maxAdversarialChars {
+		chars = chars[:maxAdversarialChars]
+	}
+
+	repeat := func(unit string) string {
+		return strings.Repeat(unit, n/len(unit)+1)[:n] + "\x00"
+	}
+
+	inputs := []string{repeat(strings.Join(chars, ""))}
+	for i, a := range chars {
+		inputs = append(inputs, repeat(a))
+		if i > 0 {
+			inputs = append(inputs, repeat(chars[i-1]+a))
+		}
+	}
+
+	return inputs
+}
+
+// fastestMatch returns the fastest of runs timings of matching input, to keep
+// scheduling noise out of the comparison.
+func fastestMatch(r *lazyRegexp, input string, runs int) time.Duration {
+	fastest := time.Duration(1<<63 - 1)
+	for i := 0; i < runs; i++ {
+		start := time.Now()
+		r.MatchString(input)
+		if elapsed := time.Since(start); elapsed < fastest {
+			fastest = elapsed
+		}
+	}
+
+	return fastest
+}
+
+// minNFAInputLength returns the input length from which the regexp package
+// matches pattern with its NFA rather than its bounded backtracker, which is
+// faster per byte, so that timings on both sides of a comparison come from the
+// same engine.
+func minNFAInputLength(t testing.TB, pattern string) int {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		t.Fatalf("parse %q: %v", pattern, err)
+	}
+	prog, err := syntax.Compile(re.Simplify())
+	if err != nil {
+		t.Fatalf("compile %q: %v", pattern, err)
+	}
+
+	// The backtracker keeps one bit per instruction and input position and
+	// is limited to 256 Kib.
+	return 256*1024/len(prog.Inst) + 1
+}
+
+// TestPatternsRunInLinearTime checks that multiplying the length of an
+// adversarial input by 8 multiplies the matching time by about 8 for every
+// pattern of the package, where a pattern with catastrophic backtracking would
+// take exponentially or at least quadratically longer. It takes several
+// seconds and only runs when VALIDATOR_TIMING_TESTS is set.
+func TestPatternsRunInLinearTime(t *testing.T) {
+	if testing.Short() || os.Getenv("VALIDATOR_TIMING_TESTS") == "" {
+		t.Skip("timing test, set VALIDATOR_TIMING_TESTS to run it")
+	}
+
+	const factor, maxRatio = 8, 32
+
+	Warmup()
+	for _, r := range lazyRegexps {
+		small := minNFAInputLength(t, r.pattern)
+		if small < 2048 {
+			small = 2048
+		}
+		shortInputs := adversarialInputs(t, r.pattern, small)
+		longInputs := adversarialInputs(t, r.pattern, small*factor)
+		for i := range shortInputs {
+			short, long := matchTimings(r, shortInputs[i], longInputs[i], 3)
+			if float64(long)/float64(short) > maxRatio {
+				// Measure again more carefully before failing on what may
+				// be noise.
+				short, long = matchTimings(r, shortInputs[i], longInputs[i], 20)
+			}
+			if float64(long)/float64(short) > maxRatio {
+				t.Errorf("pattern %q took %v on %d bytes and %v on %d bytes of %q...",
+					r.pattern, short, small, long, small*factor, shortInputs[i][:8])
+			}
+		}
+	}
+}
+
+func matchTimings(r *lazyRegexp, short string, long string, runs int) (time.Duration, time.Duration) {
+	shortTime := fastestMatch(r, short, runs)
+	if shortTime < time.Microsecond {
+		shortTime = time.Microsecond
+	}
+
+	return shortTime, fastestMatch(r, long, runs)
+}
+
+func FuzzPatternsWithMaxInputLength(f *testing.F) {
+	f.Add("john.doe@example.com", 8)
+	f.Add("data:text/plain;base64,SGVsbG8=", 64)
+	f.Add(strings.Repeat("a", 100)+"!", 50)
+
+	Warmup()
+	defer SetMaxInputLength(0)
+	f.Fuzz(func(t *testing.T, input string, limit int) {
+		for _, r := range lazyRegexps {
+			SetMaxInputLength(0)
+			expected := r.MatchString(input)
+			SetMaxInputLength(limit)
+			if limit > 0 && len(input) > limit {
+				expected = false
+			}
+			if actual := r.MatchString(input); actual != expected {
+				t.Errorf("pattern %q on %q with limit %d = %v, expected %v", r.pattern, input, limit, actual, expected)
+			}
+		}
+	})
+}
+
+func BenchmarkAdversarialInput(b *testing.B) {
+	patterns := map[string]*lazyRegexp{
+		"email":     emailRegex,
+		"dataURI":   dataURIRegex,
+		"ipAddress": ipAddressRegex,
+		"domain":    domainNameRegex,
+		"float":     floatRegex,
+	}
+
+	for name, r := range patterns {
+		for _, size := range []int{1 << 10, 1 << 13, 1 << 16} {
+			inputs := adversarialInputs(b, r.pattern, size)
+			b.Run(name+"/"+strconv.Itoa(size), func(b *testing.B) {
+				b.SetBytes(int64(size))
+				for i := 0; i < b.N; i++ {
+					r.MatchString(inputs[i%len(inputs)])
+				}
+			})
+		}
+	}
+}
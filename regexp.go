@@ -1,12 +1,15 @@
 package validator
 
-import "regexp"
+import (
+	"regexp"
+	"sync"
+)
 
 const (
 	alphaRegexString	             = "^[a-zA-Z]+$"
 	alphaNumericRegexString          = "^[a-zA-Z0-9]+$"
-	alphaUnicodeRegexString          = "^[\\p{L}]+$"
-	alphaUnicodeNumericRegexString   = "^[\\p{L}\\p{N}]+$"
+	alphaUnicodeRegexString          = "^(?:\\p{L}\\p{M}*)+$"
+	alphaUnicodeNumericRegexString   = "^(?:[\\p{L}\\p{N}]\\p{M}*)+$"
 	numericRegexString               = "^[-+]?[0-9]+(?:\\.[0-9]+)?$"
 	numberRegexString                = "^[0-9]+$"
 	hexadecimalRegexString           = "^(0[xX])?[0-9a-fA-F]+$"
@@ -41,47 +44,122 @@ const (
 	ethAddressLowerRegexString       = `^0x[0-9a-f]{40}$`
 	urlEncodedRegexString            = `(%[A-Fa-f0-9]{2})`
 	htmlEncodedRegexString           = `&#[x]?([0-9a-fA-F]{2})|(&gt)|(&lt)|(&quot)|(&amp)+[;]?`
-	htmlRegexString                  = `<[/]?([a-zA-Z]+).*?>`
+	integerRegexString               = `^[+-]?[0-9]+$`
+	floatRegexString                 = `^[+-]?(?:[0-9]+\.?[0-9]*|\.[0-9]+)(?:[eE][+-]?[0-9]+)?$`
+	decimalRegexString               = `^[+-]?(?:[0-9]+\.?[0-9]*|\.[0-9]+)$`
+	iso8601DurationRegexString       = `^P(?:(\d+(?:[.,]\d+)?)Y)?(?:(\d+(?:[.,]\d+)?)M)?(?:(\d+(?:[.,]\d+)?)W)?(?:(\d+(?:[.,]\d+)?)D)?(?:(T)(?:(\d+(?:[.,]\d+)?)H)?(?:(\d+(?:[.,]\d+)?)M)?(?:(\d+(?:[.,]\d+)?)S)?)?$`
+)
+
+var (
+	alphaRegex                 = lazyCompile(alphaRegexString)
+	alphaNumericRegex          = lazyCompile(alphaNumericRegexString)
+	alphaUnicodeRegex          = lazyCompile(alphaUnicodeRegexString)
+	alphaUnicodeNumericRegex   = lazyCompile(alphaUnicodeNumericRegexString)
+	numericRegex               = lazyCompile(numericRegexString)
+	numberRegex                = lazyCompile(numberRegexString)
+	hexadecimalRegex           = lazyCompile(hexadecimalRegexString)
+	hexcolorRegex              = lazyCompile(hexcolorRegexString)
+	rgbRegex                   = lazyCompile(rgbRegexString)
+	rgbaRegex                  = lazyCompile(rgbaRegexString)
+	hslRegex                   = lazyCompile(hslRegexString)
+	hslaRegex                  = lazyCompile(hslaRegexString)
+	emailRegex                 = lazyCompile(emailRegexString)
+	base64Regex                = lazyCompile(base64RegexString)
+	base64URLRegex             = lazyCompile(base64URLRegexString)
+	isbn10Regex                = lazyCompile(isbn10RegexString)
+	isbn13Regex                = lazyCompile(isbn13RegexString)
+	uuid3Regex                 = lazyCompile(uuid3RegexString)
+	uuid4Regex                 = lazyCompile(uuid4RegexString)
+	uuid5Regex                 = lazyCompile(uuid5RegexString)
+	uuidRegex                  = lazyCompile(uuidRegexString)
+	uuid3MixedRegex            = lazyCompile(uuid3MixedRegexString)
+	uuid4MixedRegex            = lazyCompile(uuid4MixedRegexString)
+	uuid5MixedRegex            = lazyCompile(uuid5MixedRegexString)
+	uuidMixedRegex             = lazyCompile(uuidMixedRegexString)
+	asciiRegex                 = lazyCompile(asciiRegexString)
+	printableASCIIRegex        = lazyCompile(printableASCIIRegexString)
+	multibyteCharRegex             = lazyCompile(multibyteCharRegexString)
+	dataURIRegex               = lazyCompile(dataURIRegexString)
+	latitudeRegex              = lazyCompile(latitudeRegexString)
+	longitudeRegex             = lazyCompile(longitudeRegexString)
+	ipAddressRegex 			   = lazyCompile(ipAddressRegexString)
+	domainNameRegex            = lazyCompile(domainNameRegexString)
+	ethAddressRegex            = lazyCompile(ethAddressRegexString)
+	ethAddressRegexUpper       = lazyCompile(ethAddressUpperRegexString)
+	ethAddressRegexLower       = lazyCompile(ethAddressLowerRegexString)
+	urlEncodedRegex            = lazyCompile(urlEncodedRegexString)
+	htmlEncodedRegex           = lazyCompile(htmlEncodedRegexString)
+	integerRegex               = lazyCompile(integerRegexString)
+	floatRegex                 = lazyCompile(floatRegexString)
+	decimalRegex               = lazyCompile(decimalRegexString)
+	iso8601DurationRegex       = lazyCompile(iso8601DurationRegexString)
 )
 
+// lazyRegexps holds every pattern created by lazyCompile so that Warmup can
+// compile them all. Patterns of lazy tables are added when the table is
+// loaded, so lazyRegexps is guarded by lazyRegexpsMu.
 var (
-	alphaRegex                 = regexp.MustCompile(alphaRegexString)
-	alphaNumericRegex          = regexp.MustCompile(alphaNumericRegexString)
-	alphaUnicodeRegex          = regexp.MustCompile(alphaUnicodeRegexString)
-	alphaUnicodeNumericRegex   = regexp.MustCompile(alphaUnicodeNumericRegexString)
-	numericRegex               = regexp.MustCompile(numericRegexString)
-	numberRegex                = regexp.MustCompile(numberRegexString)
-	hexadecimalRegex           = regexp.MustCompile(hexadecimalRegexString)
-	hexcolorRegex              = regexp.MustCompile(hexcolorRegexString)
-	rgbRegex                   = regexp.MustCompile(rgbRegexString)
-	rgbaRegex                  = regexp.MustCompile(rgbaRegexString)
-	hslRegex                   = regexp.MustCompile(hslRegexString)
-	hslaRegex                  = regexp.MustCompile(hslaRegexString)
-	emailRegex                 = regexp.MustCompile(emailRegexString)
-	base64Regex                = regexp.MustCompile(base64RegexString)
-	base64URLRegex             = regexp.MustCompile(base64URLRegexString)
-	isbn10Regex                = regexp.MustCompile(isbn10RegexString)
-	isbn13Regex                = regexp.MustCompile(isbn13RegexString)
-	uuid3Regex                 = regexp.MustCompile(uuid3RegexString)
-	uuid4Regex                 = regexp.MustCompile(uuid4RegexString)
-	uuid5Regex                 = regexp.MustCompile(uuid5RegexString)
-	uuidRegex                  = regexp.MustCompile(uuidRegexString)
-	uuid3MixedRegex            = regexp.MustCompile(uuid3MixedRegexString)
-	uuid4MixedRegex            = regexp.MustCompile(uuid4MixedRegexString)
-	uuid5MixedRegex            = regexp.MustCompile(uuid5MixedRegexString)
-	uuidMixedRegex             = regexp.MustCompile(uuidMixedRegexString)
-	asciiRegex                 = regexp.MustCompile(asciiRegexString)
-	printableASCIIRegex        = regexp.MustCompile(printableASCIIRegexString)
-	multibyteCharRegex             = regexp.MustCompile(multibyteCharRegexString)
-	dataURIRegex               = regexp.MustCompile(dataURIRegexString)
-	latitudeRegex              = regexp.MustCompile(latitudeRegexString)
-	longitudeRegex             = regexp.MustCompile(longitudeRegexString)
-	ipAddressRegex 			   = regexp.MustCompile(ipAddressRegexString)
-	domainNameRegex            = regexp.MustCompile(domainNameRegexString)
-	ethAddressRegex            = regexp.MustCompile(ethAddressRegexString)
-	ethAddressRegexUpper       = regexp.MustCompile(ethAddressUpperRegexString)
-	ethAddressRegexLower       = regexp.MustCompile(ethAddressLowerRegexString)
-	urlEncodedRegex            = regexp.MustCompile(urlEncodedRegexString)
-	htmlEncodedRegex           = regexp.MustCompile(htmlEncodedRegexString)
-	htmlRegex                  = regexp.MustCompile(htmlRegexString)
-)
\ No newline at end of file
+	lazyRegexpsMu sync.Mutex
+	lazyRegexps   []*lazyRegexp
+)
+
+// lazyRegexp is a regular expression compiled on first use, so that importing
+// the package does not pay for patterns that are never used.
+type lazyRegexp struct {
+	pattern string
+	once    sync.Once
+	regexp  *regexp.Regexp
+}
+
+func lazyCompile(pattern string) *lazyRegexp {
+	r := &lazyRegexp{pattern: pattern}
+	lazyRegexpsMu.Lock()
+	lazyRegexps = append(lazyRegexps, r)
+	lazyRegexpsMu.Unlock()
+
+	return r
+}
+
+func (r *lazyRegexp) compiled() *regexp.Regexp {
+	r.once.Do(func() {
+		r.regexp = regexp.MustCompile(r.pattern)
+	})
+
+	return r.regexp
+}
+
+// MatchString reports whether str matches the pattern. Inputs longer than
+// MaxInputLength never match.
+func (r *lazyRegexp) MatchString(str string) bool {
+	if exceedsMaxInputLength(str) {
+		return false
+	}
+
+	return r.compiled().MatchString(str)
+}
+
+// FindStringSubmatch returns the submatches of str, or nil when str does not
+// match or is longer than MaxInputLength.
+func (r *lazyRegexp) FindStringSubmatch(str string) []string {
+	if exceedsMaxInputLength(str) {
+		return nil
+	}
+
+	return r.compiled().FindStringSubmatch(str)
+}
+
+// Warmup compiles every regular expression and parses every data table used
+// by the package. Both are otherwise done on first use; long-running servers
+// can call Warmup at startup to move that cost out of the first requests.
+func Warmup() {
+	for _, t := range lazyTables {
+		t.load()
+	}
+
+	lazyRegexpsMu.Lock()
+	regexps := lazyRegexps
+	lazyRegexpsMu.Unlock()
+	for _, r := range regexps {
+		r.compiled()
+	}
+}
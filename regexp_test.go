@@ -0,0 +1,95 @@
+package validator
+
+import (
+	"os"
+	"sync"
+	"testing"
+)
+
+// initCompiled and initLoaded count the patterns and tables that were loaded
+// when TestMain started.
+var initCompiled, initLoaded int
+
+func TestMain(m *testing.M) {
+	for _, r := range lazyRegexps {
+		if r.regexp != nil {
+			initCompiled++
+		}
+	}
+	for _, t := range lazyTables {
+		if t.isLoaded() {
+			initLoaded++
+		}
+	}
+
+	os.Exit(m.Run())
+}
+
+func TestLazyRegexpConcurrentFirstUse(t *testing.T) {
+	r := &lazyRegexp{pattern: uuidRegexString}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if !r.MatchString("a987fbc9-4bed-3078-cf07-9141ba07c9f3") {
+				t.Error("expected a match")
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestWarmup(t *testing.T) {
+	Warmup()
+
+	for _, r := range lazyRegexps {
+		if r.regexp == nil {
+			t.Errorf("pattern %q was not compiled", r.pattern)
+		}
+	}
+	if len(lazyRegexps) < len(postalCodeRegexStrings) {
+		t.Errorf("postal code patterns are not registered")
+	}
+}
+
+// TestInitIsLazy checks that initializing the package neither compiled a
+// pattern nor parsed a data table, as recorded by TestMain before any test
+// ran. GODEBUG=inittrace=1 shows what the initialization of the package
+// costs.
+func TestInitIsLazy(t *testing.T) {
+	if initCompiled > 0 || initLoaded > 0 {
+		t.Errorf("package initialization compiled %d patterns and loaded %d tables", initCompiled, initLoaded)
+	}
+}
+
+// BenchmarkFirstCall measures the latency of the first call of a validator,
+// which pays for compiling its pattern, against subsequent calls.
+func BenchmarkFirstCall(b *testing.B) {
+	patterns := map[string]struct {
+		pattern string
+		input   string
+	}{
+		"email":     {emailRegexString, "john.doe@example.com"},
+		"ipAddress": {ipAddressRegexString, "2001:db8::ff00:42:8329"},
+		"rgb":       {rgbRegexString, "rgb(12, 34, 56)"},
+	}
+
+	for name, p := range patterns {
+		b.Run(name+"/first", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				r := &lazyRegexp{pattern: p.pattern}
+				r.MatchString(p.input)
+			}
+		})
+		b.Run(name+"/warm", func(b *testing.B) {
+			r := &lazyRegexp{pattern: p.pattern}
+			r.MatchString(p.input)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				r.MatchString(p.input)
+			}
+		})
+	}
+}
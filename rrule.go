@@ -0,0 +1,215 @@
+package validator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// RRuleError describes the part of an RFC 5545 recurrence rule that failed
+// validation.
+type RRuleError struct {
+	Part   string
+	Value  string
+	Reason string
+}
+
+func (e *RRuleError) Error() string {
+	return fmt.Sprintf("rrule: invalid %s %q: %s", e.Part, e.Value, e.Reason)
+}
+
+var (
+	rruleFrequencies = map[string]struct{}{
+		"SECONDLY": {}, "MINUTELY": {}, "HOURLY": {}, "DAILY": {},
+		"WEEKLY": {}, "MONTHLY": {}, "YEARLY": {},
+	}
+	rruleWeekdays = map[string]struct{}{
+		"MO": {}, "TU": {}, "WE": {}, "TH": {}, "FR": {}, "SA": {}, "SU": {},
+	}
+	rruleNumericLists = map[string]struct {
+		min, max int
+		signed   bool
+	}{
+		"BYSECOND":   {0, 60, false},
+		"BYMINUTE":   {0, 59, false},
+		"BYHOUR":     {0, 23, false},
+		"BYMONTH":    {1, 12, false},
+		"BYMONTHDAY": {1, 31, true},
+		"BYYEARDAY":  {1, 366, true},
+		"BYWEEKNO":   {1, 53, true},
+		"BYSETPOS":   {1, 366, true},
+	}
+)
+
+func IsRRule(str string) bool {
+	return ValidateRRule(str) == nil
+}
+
+// ValidateRRule checks an RFC 5545 recurrence rule such as
+// FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE. A leading "RRULE:" is accepted. The
+// returned error is a *RRuleError naming the offending rule part.
+func ValidateRRule(str string) error {
+	rule := strings.TrimPrefix(str, "RRULE:")
+	if rule == "" {
+		return &RRuleError{Part: "rule", Value: str, Reason: "empty rule"}
+	}
+
+	// parts keeps the rule parts in input order so that the first invalid
+	// one is reported; values indexes them by name.
+	var parts []rrulePart
+	values := make(map[string]string)
+	for _, part := range strings.Split(rule, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		if !ok || name == "" {
+			return &RRuleError{Part: "rule part", Value: part, Reason: "expected NAME=VALUE"}
+		}
+		name = strings.ToUpper(name)
+		if _, duplicate := values[name]; duplicate {
+			return &RRuleError{Part: name, Value: value, Reason: "specified more than once"}
+		}
+		if value == "" {
+			return &RRuleError{Part: name, Value: value, Reason: "empty value"}
+		}
+		value = strings.ToUpper(value)
+		parts = append(parts, rrulePart{name: name, value: value})
+		values[name] = value
+	}
+
+	freq, ok := values["FREQ"]
+	if !ok {
+		return &RRuleError{Part: "FREQ", Value: "", Reason: "FREQ is required"}
+	}
+
+	for _, part := range parts {
+		if reason := validateRRulePart(part.name, part.value, freq); reason != "" {
+			return &RRuleError{Part: part.name, Value: part.value, Reason: reason}
+		}
+	}
+
+	if _, hasUntil := values["UNTIL"]; hasUntil {
+		if _, hasCount := values["COUNT"]; hasCount {
+			return &RRuleError{Part: "COUNT", Value: values["COUNT"], Reason: "UNTIL and COUNT are mutually exclusive"}
+		}
+	}
+
+	if setpos, ok := values["BYSETPOS"]; ok {
+		hasOther := false
+		for _, part := range parts {
+			if part.name != "BYSETPOS" && strings.HasPrefix(part.name, "BY") {
+				hasOther = true
+			}
+		}
+		if !hasOther {
+			return &RRuleError{Part: "BYSETPOS", Value: setpos, Reason: "requires another BYxxx rule part"}
+		}
+	}
+
+	return nil
+}
+
+// rrulePart is a NAME=VALUE part of a recurrence rule, with both in upper
+// case.
+type rrulePart struct {
+	name  string
+	value string
+}
+
+func validateRRulePart(name string, value string, freq string) string {
+	switch name {
+	case "FREQ":
+		if _, ok := rruleFrequencies[value]; !ok {
+			return "unknown frequency"
+		}
+	case "UNTIL":
+		if !isRRuleDate(value) {
+			return "expected a DATE or DATE-TIME value"
+		}
+	case "COUNT", "INTERVAL":
+		n, err := strconv.Atoi(value)
+		if err != nil || !isASCIIDigits(value) || n < 1 {
+			return "expected a positive integer"
+		}
+	case "WKST":
+		if _, ok := rruleWeekdays[value]; !ok {
+			return "expected a weekday (MO-SU)"
+		}
+	case "BYDAY":
+		for _, day := range strings.Split(value, ",") {
+			if reason := validateRRuleWeekday(day, freq); reason != "" {
+				return reason
+			}
+		}
+	default:
+		list, ok := rruleNumericLists[name]
+		if !ok {
+			return "unknown rule part"
+		}
+		if name == "BYWEEKNO" && freq != "YEARLY" {
+			return "only allowed with FREQ=YEARLY"
+		}
+		if name == "BYMONTHDAY" && freq == "WEEKLY" {
+			return "not allowed with FREQ=WEEKLY"
+		}
+		if name == "BYYEARDAY" && (freq == "DAILY" || freq == "WEEKLY" || freq == "MONTHLY") {
+			return fmt.Sprintf("not allowed with FREQ=%s", freq)
+		}
+		for _, item := range strings.Split(value, ",") {
+			if !isRRuleNumber(item, list.min, list.max, list.signed) {
+				return fmt.Sprintf("%q is out of range", item)
+			}
+		}
+	}
+
+	return ""
+}
+
+func validateRRuleWeekday(day string, freq string) string {
+	if len(day) < 2 {
+		return fmt.Sprintf("%q is not a weekday", day)
+	}
+
+	ordinal, weekday := day[:len(day)-2], day[len(day)-2:]
+	if _, ok := rruleWeekdays[weekday]; !ok {
+		return fmt.Sprintf("%q is not a weekday", day)
+	}
+	if ordinal == "" {
+		return ""
+	}
+	if freq != "MONTHLY" && freq != "YEARLY" {
+		return "numeric weekday prefixes are only allowed with FREQ=MONTHLY or FREQ=YEARLY"
+	}
+	if !isRRuleNumber(ordinal, 1, 53, true) {
+		return fmt.Sprintf("%q has an invalid ordinal", day)
+	}
+
+	return ""
+}
+
+func isRRuleNumber(str string, min int, max int, signed bool) bool {
+	if signed && str != "" && (str[0] == '+' || str[0] == '-') {
+		str = str[1:]
+	}
+	if str == "" || !isASCIIDigits(str) {
+		return false
+	}
+
+	n, err := strconv.Atoi(str)
+	return err == nil && n >= min && n <= max
+}
+
+func isRRuleDate(str string) bool {
+	date, clock, hasTime := strings.Cut(str, "T")
+	if len(date) != 8 || !isASCIIDigits(date) || !isValidDate(atoi(date[:4]), atoi(date[4:6]), atoi(date[6:])) {
+		return false
+	}
+	if !hasTime {
+		return true
+	}
+
+	clock = strings.TrimSuffix(clock, "Z")
+	if len(clock) != 6 || !isASCIIDigits(clock) {
+		return false
+	}
+
+	return atoi(clock[:2]) <= 23 && atoi(clock[2:4]) <= 59 && atoi(clock[4:]) <= 60
+}
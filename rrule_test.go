@@ -0,0 +1,66 @@
+package validator_test
+
+import (
+	validator "github.com/MrWormHole/simple-validator"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestIsRRule(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []testCase{
+		{"FREQ=DAILY", true},
+		{"RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE,FR", true},
+		{"FREQ=MONTHLY;BYDAY=-1FR", true},
+		{"FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1", true},
+		{"FREQ=YEARLY;BYWEEKNO=20;BYDAY=MO", true},
+		{"FREQ=DAILY;UNTIL=20231231T235959Z", true},
+		{"FREQ=DAILY;UNTIL=20231231", true},
+		{"FREQ=DAILY;COUNT=10", true},
+		{"freq=daily;wkst=su", true},
+		{"", false},
+		{"INTERVAL=2", false},
+		{"FREQ=FORTNIGHTLY", false},
+		{"FREQ=DAILY;COUNT=10;UNTIL=20231231", false},
+		{"FREQ=DAILY;COUNT=0", false},
+		{"FREQ=DAILY;INTERVAL=-1", false},
+		{"FREQ=DAILY;UNTIL=20230231", false},
+		{"FREQ=WEEKLY;BYDAY=1MO", false},
+		{"FREQ=WEEKLY;BYDAY=XX", false},
+		{"FREQ=MONTHLY;BYDAY=54MO", false},
+		{"FREQ=WEEKLY;BYMONTHDAY=1", false},
+		{"FREQ=MONTHLY;BYWEEKNO=1", false},
+		{"FREQ=MONTHLY;BYYEARDAY=100", false},
+		{"FREQ=MONTHLY;BYMONTHDAY=0", false},
+		{"FREQ=YEARLY;BYMONTH=13", false},
+		{"FREQ=DAILY;BYSETPOS=1", false},
+		{"FREQ=DAILY;FREQ=WEEKLY", false},
+		{"FREQ=DAILY;FOO=BAR", false},
+		{"FREQ=DAILY;", false},
+	}
+
+	for _, t := range testCases {
+		actual := validator.IsRRule(t.param)
+		assert.Equal(t.expected, actual, t.param)
+	}
+}
+
+func TestValidateRRule(t *testing.T) {
+	assert := assert.New(t)
+
+	err := validator.ValidateRRule("FREQ=DAILY;BYHOUR=24")
+	var rruleErr *validator.RRuleError
+	assert.ErrorAs(err, &rruleErr)
+	assert.Equal("BYHOUR", rruleErr.Part)
+	assert.EqualError(err, `rrule: invalid BYHOUR "24": "24" is out of range`)
+
+	assert.NoError(validator.ValidateRRule("FREQ=HOURLY;BYMINUTE=0,30"))
+
+	// The first invalid part in input order is reported.
+	for i := 0; i < 20; i++ {
+		err = validator.ValidateRRule("FREQ=DAILY;BYMONTH=13;BYHOUR=24;INTERVAL=0;WKST=XX")
+		assert.ErrorAs(err, &rruleErr)
+		assert.Equal("BYMONTH", rruleErr.Part)
+	}
+}
@@ -0,0 +1,197 @@
+package validator
+
+import (
+	"fmt"
+	"time"
+)
+
+// Ordered is the set of types that support the < operator, as in
+// golang.org/x/exp/constraints.
+type Ordered interface {
+	Number | ~string
+}
+
+// Rule validates a value of type T and returns a *RuleError describing why it
+// is invalid, or nil. Rules are plain functions, so any func(T) error can be
+// converted to one:
+//
+//	age := validator.Between(18, 130)
+//	tags := validator.All(validator.Len[[]string](1, 10), validator.Unique[[]string]())
+//	id := validator.Predicate("uuid", validator.IsUUID)
+//
+//	if err := age(user.Age); err != nil {
+//		...
+//	}
+type Rule[T any] func(value T) error
+
+// RuleError describes a value rejected by a Rule. Rule is a stable identifier
+// such as "min" or the name given to Predicate, and Message describes the
+// violation. RuleErrors match ErrInvalid with errors.Is.
+type RuleError struct {
+	Rule    string
+	Message string
+}
+
+func (e *RuleError) Error() string {
+	return e.Message
+}
+
+// Is reports whether target is ErrInvalid.
+func (e *RuleError) Is(target error) bool {
+	return target == ErrInvalid
+}
+
+// Min returns a rule that accepts values greater than or equal to min. Like
+// the other ordered rules, it rejects NaN.
+func Min[T Ordered](min T) Rule[T] {
+	return func(value T) error {
+		if !(value >= min) {
+			return &RuleError{Rule: "min", Message: fmt.Sprintf("must be at least %v", min)}
+		}
+		return nil
+	}
+}
+
+// Max returns a rule that accepts values less than or equal to max.
+func Max[T Ordered](max T) Rule[T] {
+	return func(value T) error {
+		if !(value <= max) {
+			return &RuleError{Rule: "max", Message: fmt.Sprintf("must be at most %v", max)}
+		}
+		return nil
+	}
+}
+
+// Between returns a rule that accepts values within the inclusive range
+// [min, max].
+func Between[T Ordered](min T, max T) Rule[T] {
+	return func(value T) error {
+		if !(value >= min && value <= max) {
+			return &RuleError{Rule: "between", Message: fmt.Sprintf("must be between %v and %v", min, max)}
+		}
+		return nil
+	}
+}
+
+// After returns a rule that accepts times strictly after t.
+func After(t time.Time) Rule[time.Time] {
+	return func(value time.Time) error {
+		if !value.After(t) {
+			return &RuleError{Rule: "after", Message: "must be after " + t.Format(time.RFC3339)}
+		}
+		return nil
+	}
+}
+
+// Before returns a rule that accepts times strictly before t.
+func Before(t time.Time) Rule[time.Time] {
+	return func(value time.Time) error {
+		if !value.Before(t) {
+			return &RuleError{Rule: "before", Message: "must be before " + t.Format(time.RFC3339)}
+		}
+		return nil
+	}
+}
+
+// OneOf returns a rule that accepts the given values only.
+func OneOf[T comparable](values ...T) Rule[T] {
+	return func(value T) error {
+		for _, allowed := range values {
+			if value == allowed {
+				return nil
+			}
+		}
+		return &RuleError{Rule: "oneof", Message: fmt.Sprintf("must be one of %v", values)}
+	}
+}
+
+// Len returns a rule that accepts slices with min to max elements. A max of 0
+// means no maximum.
+func Len[S ~[]E, E any](min int, max int) Rule[S] {
+	return func(value S) error {
+		return checkLen(len(value), min, max)
+	}
+}
+
+// MapLen returns a rule that accepts maps with min to max entries. A max of 0
+// means no maximum.
+func MapLen[M ~map[K]V, K comparable, V any](min int, max int) Rule[M] {
+	return func(value M) error {
+		return checkLen(len(value), min, max)
+	}
+}
+
+func checkLen(length int, min int, max int) error {
+	switch {
+	case length < min:
+		return &RuleError{Rule: "len", Message: fmt.Sprintf("must have at least %d elements", min)}
+	case max > 0 && length > max:
+		return &RuleError{Rule: "len", Message: fmt.Sprintf("must have at most %d elements", max)}
+	}
+
+	return nil
+}
+
+// Unique returns a rule that rejects slices containing the same element twice.
+func Unique[S ~[]E, E comparable]() Rule[S] {
+	return func(value S) error {
+		seen := make(map[E]struct{}, len(value))
+		for i, element := range value {
+			if _, ok := seen[element]; ok {
+				return &RuleError{Rule: "unique", Message: fmt.Sprintf("must not contain duplicates, found %v again at index %d", element, i)}
+			}
+			seen[element] = struct{}{}
+		}
+		return nil
+	}
+}
+
+// Predicate lifts a boolean validator such as IsUUID into a rule. name is
+// used as the Rule of the error and in its message.
+func Predicate[T any](name string, fn func(T) bool) Rule[T] {
+	return func(value T) error {
+		if !fn(value) {
+			return &RuleError{Rule: name, Message: "must be a valid " + name}
+		}
+		return nil
+	}
+}
+
+// All returns a rule that applies rules in order and returns the first error.
+func All[T any](rules ...Rule[T]) Rule[T] {
+	return func(value T) error {
+		for _, rule := range rules {
+			if err := rule(value); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// Each returns a rule that applies rule to every element of a slice and
+// returns the first error, prefixed with the index of the element.
+func Each[S ~[]E, E any](rule Rule[E]) Rule[S] {
+	return func(value S) error {
+		for i, element := range value {
+			if err := rule(element); err != nil {
+				return &ElementError{Index: i, Err: err}
+			}
+		}
+		return nil
+	}
+}
+
+// ElementError is returned by Each for the first invalid element of a slice.
+type ElementError struct {
+	Index int
+	Err   error
+}
+
+func (e *ElementError) Error() string {
+	return fmt.Sprintf("element %d: %v", e.Index, e.Err)
+}
+
+func (e *ElementError) Unwrap() error {
+	return e.Err
+}
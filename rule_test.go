@@ -0,0 +1,112 @@
+package validator_test
+
+import (
+	"errors"
+	validator "github.com/MrWormHole/simple-validator"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+	"time"
+)
+
+type userID int64
+
+func TestOrderedRules(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NoError(validator.Min(18)(18))
+	assert.EqualError(validator.Min(18)(17), "must be at least 18")
+	assert.NoError(validator.Max(0.5)(0.25))
+	assert.EqualError(validator.Max(0.5)(0.75), "must be at most 0.5")
+	assert.NoError(validator.Between("b", "d")("c"))
+	assert.EqualError(validator.Between("b", "d")("e"), "must be between b and d")
+	assert.NoError(validator.Between[userID](1, 1000)(42))
+	assert.Error(validator.Between[userID](1, 1000)(0))
+	assert.NoError(validator.Min[uint8](0)(0))
+	assert.Error(validator.Min(0.0)(math.NaN()))
+	assert.Error(validator.Max(1.0)(math.NaN()))
+	assert.Error(validator.Between(0.0, 1.0)(math.NaN()))
+	assert.Error(validator.Between(math.Inf(-1), math.Inf(1))(math.NaN()))
+	assert.NoError(validator.Between(math.Inf(-1), math.Inf(1))(math.MaxFloat64))
+
+	var ruleErr *validator.RuleError
+	err := validator.Between(1, 10)(11)
+	assert.True(errors.As(err, &ruleErr))
+	assert.Equal("between", ruleErr.Rule)
+	assert.ErrorIs(err, validator.ErrInvalid)
+}
+
+func TestTimeRules(t *testing.T) {
+	assert := assert.New(t)
+
+	epoch := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.NoError(validator.After(epoch)(epoch.Add(time.Second)))
+	assert.EqualError(validator.After(epoch)(epoch), "must be after 2020-01-01T00:00:00Z")
+	assert.NoError(validator.Before(epoch)(epoch.Add(-time.Second)))
+	assert.Error(validator.Before(epoch)(epoch))
+}
+
+func TestOneOf(t *testing.T) {
+	assert := assert.New(t)
+
+	status := validator.OneOf("draft", "published")
+
+	assert.NoError(status("draft"))
+	assert.EqualError(status("deleted"), "must be one of [draft published]")
+	assert.NoError(validator.OneOf(1, 2, 3)(3))
+	assert.Error(validator.OneOf[int]()(0))
+}
+
+func TestLenAndUnique(t *testing.T) {
+	assert := assert.New(t)
+
+	tags := validator.Len[[]string](1, 3)
+	assert.NoError(tags([]string{"a"}))
+	assert.EqualError(tags(nil), "must have at least 1 elements")
+	assert.EqualError(tags([]string{"a", "b", "c", "d"}), "must have at most 3 elements")
+	assert.NoError(validator.Len[[]int](0, 0)(make([]int, 1000)))
+
+	labels := validator.MapLen[map[string]string](1, 2)
+	assert.NoError(labels(map[string]string{"env": "prod"}))
+	assert.Error(labels(map[string]string{}))
+	assert.Error(labels(map[string]string{"a": "", "b": "", "c": ""}))
+
+	unique := validator.Unique[[]string]()
+	assert.NoError(unique([]string{"a", "b"}))
+	assert.NoError(unique(nil))
+	assert.EqualError(unique([]string{"a", "b", "a"}), "must not contain duplicates, found a again at index 2")
+}
+
+func TestPredicate(t *testing.T) {
+	assert := assert.New(t)
+
+	uuid := validator.Predicate("uuid", validator.IsUUID)
+	assert.NoError(uuid("a987fbc9-4bed-3078-cf07-9141ba07c9f3"))
+	assert.EqualError(uuid("a987fbc9"), "must be a valid uuid")
+
+	even := validator.Predicate("even", func(n int) bool { return n%2 == 0 })
+	assert.NoError(even(2))
+	assert.Error(even(3))
+}
+
+func TestAllAndEach(t *testing.T) {
+	assert := assert.New(t)
+
+	ids := validator.All(
+		validator.Len[[]string](1, 0),
+		validator.Unique[[]string](),
+		validator.Each[[]string](validator.Predicate("uuid", validator.IsUUID)),
+	)
+
+	assert.NoError(ids([]string{"a987fbc9-4bed-3078-cf07-9141ba07c9f3"}))
+	assert.EqualError(ids(nil), "must have at least 1 elements")
+
+	err := ids([]string{"a987fbc9-4bed-3078-cf07-9141ba07c9f3", "x"})
+	assert.EqualError(err, "element 1: must be a valid uuid")
+
+	var elementErr *validator.ElementError
+	assert.True(errors.As(err, &elementErr))
+	assert.Equal(1, elementErr.Index)
+	assert.ErrorIs(err, validator.ErrInvalid)
+}
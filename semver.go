@@ -0,0 +1,468 @@
+package validator
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// SemVer is a parsed Semantic Versioning 2.0.0 version.
+type SemVer struct {
+	Major      uint64
+	Minor      uint64
+	Patch      uint64
+	Prerelease []string
+	Build      []string
+}
+
+var ErrInvalidSemVer = errors.New("invalid semantic version")
+
+func IsSemVer(str string) bool {
+	_, err := ParseSemVer(str)
+	return err == nil
+}
+
+// ParseSemVer parses a SemVer 2.0.0 version with optional prerelease and build
+// metadata. A single leading "v" is accepted.
+func ParseSemVer(str string) (SemVer, error) {
+	version, _, ok := parsePartialSemVer(strings.TrimPrefix(str, "v"), false)
+	if !ok {
+		return SemVer{}, ErrInvalidSemVer
+	}
+
+	return version, nil
+}
+
+// parsePartialSemVer parses a version. When partial is set the minor and
+// patch components may be omitted or given as x, X or *; the returned count
+// reports how many numeric components were present.
+func parsePartialSemVer(str string, partial bool) (SemVer, int, bool) {
+	var version SemVer
+
+	core, build, hasBuild := strings.Cut(str, "+")
+	if hasBuild {
+		version.Build = strings.Split(build, ".")
+		for _, identifier := range version.Build {
+			if !isSemVerIdentifier(identifier) {
+				return SemVer{}, 0, false
+			}
+		}
+	}
+
+	core, prerelease, hasPrerelease := strings.Cut(core, "-")
+	if hasPrerelease {
+		version.Prerelease = strings.Split(prerelease, ".")
+		for _, identifier := range version.Prerelease {
+			if !isSemVerIdentifier(identifier) || isASCIIDigits(identifier) && len(identifier) > 1 && identifier[0] == '0' {
+				return SemVer{}, 0, false
+			}
+		}
+	}
+
+	parts := strings.Split(core, ".")
+	if len(parts) > 3 || !partial && len(parts) != 3 {
+		return SemVer{}, 0, false
+	}
+
+	numbers := []*uint64{&version.Major, &version.Minor, &version.Patch}
+	count := 0
+	for i, part := range parts {
+		if partial && i > 0 && isSemVerWildcard(part) {
+			for _, rest := range parts[i:] {
+				if !isSemVerWildcard(rest) {
+					return SemVer{}, 0, false
+				}
+			}
+			break
+		}
+		if part == "" || !isASCIIDigits(part) || len(part) > 1 && part[0] == '0' {
+			return SemVer{}, 0, false
+		}
+		n, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return SemVer{}, 0, false
+		}
+		*numbers[i] = n
+		count++
+	}
+	if count < len(parts) && (hasPrerelease || hasBuild) {
+		return SemVer{}, 0, false
+	}
+
+	return version, count, true
+}
+
+func isSemVerWildcard(part string) bool {
+	return part == "x" || part == "X" || part == "*"
+}
+
+func isSemVerIdentifier(identifier string) bool {
+	if identifier == "" {
+		return false
+	}
+
+	for i := 0; i < len(identifier); i++ {
+		c := identifier[i]
+		if c != '-' && (c < '0' || c > '9') && (c|0x20 < 'a' || c|0x20 > 'z') {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (v SemVer) String() string {
+	var b strings.Builder
+	b.WriteString(strconv.FormatUint(v.Major, 10))
+	b.WriteByte('.')
+	b.WriteString(strconv.FormatUint(v.Minor, 10))
+	b.WriteByte('.')
+	b.WriteString(strconv.FormatUint(v.Patch, 10))
+	if len(v.Prerelease) > 0 {
+		b.WriteByte('-')
+		b.WriteString(strings.Join(v.Prerelease, "."))
+	}
+	if len(v.Build) > 0 {
+		b.WriteByte('+')
+		b.WriteString(strings.Join(v.Build, "."))
+	}
+
+	return b.String()
+}
+
+// Compare returns -1, 0 or +1 depending on whether v precedes, equals or
+// follows other. Build metadata is ignored as required by SemVer precedence.
+func (v SemVer) Compare(other SemVer) int {
+	if c := compareUint64(v.Major, other.Major); c != 0 {
+		return c
+	}
+	if c := compareUint64(v.Minor, other.Minor); c != 0 {
+		return c
+	}
+	if c := compareUint64(v.Patch, other.Patch); c != 0 {
+		return c
+	}
+
+	switch {
+	case len(v.Prerelease) == 0 && len(other.Prerelease) == 0:
+		return 0
+	case len(v.Prerelease) == 0:
+		return 1
+	case len(other.Prerelease) == 0:
+		return -1
+	}
+
+	for i := 0; i < len(v.Prerelease) && i < len(other.Prerelease); i++ {
+		if c := comparePrereleaseIdentifier(v.Prerelease[i], other.Prerelease[i]); c != 0 {
+			return c
+		}
+	}
+
+	return compareUint64(uint64(len(v.Prerelease)), uint64(len(other.Prerelease)))
+}
+
+func (v SemVer) LessThan(other SemVer) bool {
+	return v.Compare(other) < 0
+}
+
+func (v SemVer) Equal(other SemVer) bool {
+	return v.Compare(other) == 0
+}
+
+func comparePrereleaseIdentifier(a string, b string) int {
+	aNumeric, bNumeric := isASCIIDigits(a), isASCIIDigits(b)
+	switch {
+	case aNumeric && bNumeric:
+		if c := compareUint64(uint64(len(a)), uint64(len(b))); c != 0 {
+			return c
+		}
+	case aNumeric:
+		return -1
+	case bNumeric:
+		return 1
+	}
+
+	return strings.Compare(a, b)
+}
+
+func compareUint64(a uint64, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+
+	return 0
+}
+
+// SemVerConstraint is a parsed version range. It is satisfied when any of its
+// alternatives (separated by ||) has all of its comparators satisfied.
+type SemVerConstraint struct {
+	alternatives [][]semVerComparator
+}
+
+type semVerComparator struct {
+	op      string
+	version SemVer
+}
+
+var ErrInvalidSemVerConstraint = errors.New("invalid semantic version constraint")
+
+func IsSemVerConstraint(str string) bool {
+	_, err := ParseSemVerConstraint(str)
+	return err == nil
+}
+
+// ParseSemVerConstraint parses ranges in the syntax used by npm and Composer:
+// comparators (=, !=, >, >=, <, <=) joined by spaces or commas, caret (^1.2)
+// and tilde (~1.4) ranges, wildcards (1.x, *), hyphen ranges (1.2 - 2.3) and
+// alternatives separated by ||.
+func ParseSemVerConstraint(str string) (SemVerConstraint, error) {
+	var constraint SemVerConstraint
+
+	for _, alternative := range strings.Split(str, "||") {
+		fields := strings.Fields(strings.ReplaceAll(alternative, ",", " "))
+		if len(fields) == 0 {
+			return SemVerConstraint{}, ErrInvalidSemVerConstraint
+		}
+
+		var comparators []semVerComparator
+		for i := 0; i < len(fields); i++ {
+			if i+2 < len(fields) && fields[i+1] == "-" {
+				hyphen, ok := parseSemVerHyphenRange(fields[i], fields[i+2])
+				if !ok {
+					return SemVerConstraint{}, ErrInvalidSemVerConstraint
+				}
+				comparators = append(comparators, hyphen...)
+				i += 2
+				continue
+			}
+
+			field := fields[i]
+			if isSemVerOperator(field) && i+1 < len(fields) {
+				field += fields[i+1]
+				i++
+			}
+
+			expanded, ok := parseSemVerComparator(field)
+			if !ok {
+				return SemVerConstraint{}, ErrInvalidSemVerConstraint
+			}
+			comparators = append(comparators, expanded...)
+		}
+
+		constraint.alternatives = append(constraint.alternatives, comparators)
+	}
+
+	return constraint, nil
+}
+
+// Check reports whether version satisfies the constraint.
+func (c SemVerConstraint) Check(version SemVer) bool {
+	for _, comparators := range c.alternatives {
+		satisfied := true
+		for _, comparator := range comparators {
+			if !comparator.check(version) {
+				satisfied = false
+				break
+			}
+		}
+		if satisfied {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c semVerComparator) check(version SemVer) bool {
+	cmp := version.Compare(c.version)
+	switch c.op {
+	case "=":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	}
+
+	return true
+}
+
+func isSemVerOperator(str string) bool {
+	switch str {
+	case "=", "==", "!=", ">", ">=", "<", "<=", "^", "~", "~>":
+		return true
+	}
+
+	return false
+}
+
+func parseSemVerComparator(str string) ([]semVerComparator, bool) {
+	op := ""
+	for _, candidate := range []string{"!=", ">=", "<=", "==", "~>", "=", ">", "<", "^", "~"} {
+		if strings.HasPrefix(str, candidate) {
+			op = candidate
+			break
+		}
+	}
+
+	version, count, ok := parsePartialSemVer(strings.TrimPrefix(strings.TrimPrefix(str, op), "v"), true)
+	if str == op+"*" || str == op+"x" || str == op+"X" {
+		version, count, ok = SemVer{}, 0, op == "" || op == "=" || op == "=="
+	}
+	if !ok {
+		return nil, false
+	}
+
+	switch op {
+	case "^":
+		return []semVerComparator{{">=", version}, {"<", caretUpperBound(version, count)}}, true
+	case "~", "~>":
+		return []semVerComparator{{">=", version}, {"<", tildeUpperBound(version, count)}}, true
+	case "", "=", "==":
+		if count == 0 {
+			return nil, true
+		}
+		if count < 3 {
+			return []semVerComparator{{">=", version}, {"<", wildcardUpperBound(version, count)}}, true
+		}
+		return []semVerComparator{{"=", version}}, true
+	case "<":
+		return []semVerComparator{{op, version}}, true
+	case "<=", ">":
+		if count < 3 {
+			upper := wildcardUpperBound(version, count)
+			if op == "<=" {
+				return []semVerComparator{{"<", upper}}, true
+			}
+			return []semVerComparator{{">=", upper}}, true
+		}
+		return []semVerComparator{{op, version}}, true
+	}
+
+	return []semVerComparator{{op, version}}, true
+}
+
+func parseSemVerHyphenRange(low string, high string) ([]semVerComparator, bool) {
+	from, _, ok := parsePartialSemVer(strings.TrimPrefix(low, "v"), true)
+	if !ok {
+		return nil, false
+	}
+	to, count, ok := parsePartialSemVer(strings.TrimPrefix(high, "v"), true)
+	if !ok {
+		return nil, false
+	}
+
+	if count < 3 {
+		return []semVerComparator{{">=", from}, {"<", wildcardUpperBound(to, count)}}, true
+	}
+
+	return []semVerComparator{{">=", from}, {"<=", to}}, true
+}
+
+func caretUpperBound(version SemVer, count int) SemVer {
+	switch {
+	case version.Major > 0 || count < 2:
+		return SemVer{Major: version.Major + 1, Prerelease: []string{"0"}}
+	case version.Minor > 0 || count < 3:
+		return SemVer{Minor: version.Minor + 1, Prerelease: []string{"0"}}
+	}
+
+	return SemVer{Patch: version.Patch + 1, Prerelease: []string{"0"}}
+}
+
+func tildeUpperBound(version SemVer, count int) SemVer {
+	if count < 2 {
+		return SemVer{Major: version.Major + 1, Prerelease: []string{"0"}}
+	}
+
+	return SemVer{Major: version.Major, Minor: version.Minor + 1, Prerelease: []string{"0"}}
+}
+
+func wildcardUpperBound(version SemVer, count int) SemVer {
+	if count < 2 {
+		return SemVer{Major: version.Major + 1, Prerelease: []string{"0"}}
+	}
+
+	return SemVer{Major: version.Major, Minor: version.Minor + 1, Prerelease: []string{"0"}}
+}
+
+// IsGoModuleVersion accepts Go module versions: canonical semantic versions
+// with a leading v (no build metadata except +incompatible), including
+// pseudo-versions such as v0.0.0-20191109021931-daa7c04131f5.
+func IsGoModuleVersion(str string) bool {
+	if !strings.HasPrefix(str, "v") {
+		return false
+	}
+
+	version, err := ParseSemVer(str)
+	if err != nil {
+		return false
+	}
+	if len(version.Build) > 0 && (len(version.Build) != 1 || version.Build[0] != "incompatible" || version.Major < 2) {
+		return false
+	}
+
+	if !isPseudoVersionCandidate(version) {
+		return true
+	}
+
+	return isPseudoVersion(version)
+}
+
+// isPseudoVersionCandidate reports whether the last prerelease identifier is
+// the yyyymmddhhmmss-abcdefabcdef pair that marks a pseudo-version.
+func isPseudoVersionCandidate(version SemVer) bool {
+	n := len(version.Prerelease)
+	if n == 0 {
+		return false
+	}
+
+	last := version.Prerelease[n-1]
+
+	return len(last) == 27 && last[14] == '-' && isASCIIDigits(last[:14])
+}
+
+func isPseudoVersion(version SemVer) bool {
+	n := len(version.Prerelease)
+	last := version.Prerelease[n-1]
+	if !isLowerHex(last[15:]) || !isValidTimestamp(last[:14]) {
+		return false
+	}
+
+	prefix := version.Prerelease[:n-1]
+	switch {
+	case len(prefix) == 0:
+		// vX.0.0-yyyymmddhhmmss-abcdefabcdef
+		return version.Minor == 0 && version.Patch == 0
+	case len(prefix) == 1 && prefix[0] == "0":
+		// vX.Y.(Z+1)-0.yyyymmddhhmmss-abcdefabcdef
+		return version.Patch > 0
+	}
+
+	// vX.Y.Z-pre.0.yyyymmddhhmmss-abcdefabcdef
+	return prefix[len(prefix)-1] == "0"
+}
+
+func isLowerHex(str string) bool {
+	for i := 0; i < len(str); i++ {
+		if c := str[i]; (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isValidTimestamp(str string) bool {
+	return isValidDate(atoi(str[:4]), atoi(str[4:6]), atoi(str[6:8])) &&
+		atoi(str[8:10]) <= 23 && atoi(str[10:12]) <= 59 && atoi(str[12:14]) <= 59
+}
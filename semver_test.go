@@ -0,0 +1,173 @@
+package validator_test
+
+import (
+	validator "github.com/MrWormHole/simple-validator"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestIsSemVer(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []testCase{
+		{"0.0.0", true},
+		{"1.2.3", true},
+		{"v1.2.3", true},
+		{"1.0.0-alpha", true},
+		{"1.0.0-alpha.1", true},
+		{"1.0.0-0.3.7", true},
+		{"1.0.0-x.7.z.92", true},
+		{"1.0.0-x-y-z.--", true},
+		{"1.0.0+20130313144700", true},
+		{"1.0.0-beta+exp.sha.5114f85", true},
+		{"1.0.0+21AF26D3----117B344092BD", true},
+		{"1.2", false},
+		{"1.2.3.4", false},
+		{"01.2.3", false},
+		{"1.02.3", false},
+		{"1.2.3-01", false},
+		{"1.2.3-", false},
+		{"1.2.3+", false},
+		{"1.2.3-alpha..1", false},
+		{"1.2.3-alpha_1", false},
+		{"vv1.2.3", false},
+		{"V1.2.3", false},
+		{"", false},
+	}
+
+	for _, t := range testCases {
+		actual := validator.IsSemVer(t.param)
+		assert.Equal(t.expected, actual, t.param)
+	}
+}
+
+func TestSemVerCompare(t *testing.T) {
+	assert := assert.New(t)
+
+	ordered := []string{
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0",
+		"1.0.1",
+		"1.1.0",
+		"2.0.0",
+	}
+
+	for i := 0; i+1 < len(ordered); i++ {
+		a, err := validator.ParseSemVer(ordered[i])
+		assert.NoError(err)
+		b, err := validator.ParseSemVer(ordered[i+1])
+		assert.NoError(err)
+		assert.Equal(-1, a.Compare(b), "%s < %s", a, b)
+		assert.Equal(1, b.Compare(a), "%s > %s", b, a)
+		assert.True(a.LessThan(b))
+	}
+
+	a, _ := validator.ParseSemVer("v1.0.0+build.1")
+	b, _ := validator.ParseSemVer("1.0.0+build.2")
+	assert.True(a.Equal(b))
+	assert.Equal("1.0.0+build.1", a.String())
+}
+
+func TestIsSemVerConstraint(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []testCase{
+		{"1.2.3", true},
+		{"^1.2", true},
+		{"~1.4", true},
+		{"~>1.4.2", true},
+		{">=1.0.0 <2.0.0", true},
+		{">= 1.0.0, < 2.0.0", true},
+		{"1.x", true},
+		{"*", true},
+		{"1.2 - 2.3.4", true},
+		{"^1.2.3 || ^2.0.0", true},
+		{"!=1.5.0", true},
+		{"", false},
+		{">>1.0.0", false},
+		{"^", false},
+		{"1.x.3", false},
+		{"^1.2 ||", false},
+		{"1.2.3.4", false},
+		{"latest", false},
+	}
+
+	for _, t := range testCases {
+		actual := validator.IsSemVerConstraint(t.param)
+		assert.Equal(t.expected, actual, t.param)
+	}
+}
+
+func TestSemVerConstraintCheck(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []struct {
+		constraint string
+		version    string
+		expected   bool
+	}{
+		{"^1.2", "1.2.0", true},
+		{"^1.2", "1.9.9", true},
+		{"^1.2", "2.0.0", false},
+		{"^1.2", "1.1.9", false},
+		{"^0.2.3", "0.2.9", true},
+		{"^0.2.3", "0.3.0", false},
+		{"^0.0.3", "0.0.4", false},
+		{"~1.4", "1.4.7", true},
+		{"~1.4", "1.5.0", false},
+		{">=1.0.0 <2.0.0", "1.5.0", true},
+		{">=1.0.0 <2.0.0", "2.0.0", false},
+		{"1.x", "1.99.0", true},
+		{"1.x", "2.0.0", false},
+		{"*", "9.9.9", true},
+		{"1.2 - 2.3", "2.3.9", true},
+		{"1.2 - 2.3", "2.4.0", false},
+		{"1.2.3 - 2.3.4", "2.3.4", true},
+		{"<=1.2", "1.2.9", true},
+		{">1.2", "1.2.9", false},
+		{">1.2", "1.3.0", true},
+		{"^1.0.0 || ^3.0.0", "3.1.0", true},
+		{"^1.0.0 || ^3.0.0", "2.1.0", false},
+		{"!=1.5.0", "1.5.0", false},
+	}
+
+	for _, t := range testCases {
+		constraint, err := validator.ParseSemVerConstraint(t.constraint)
+		assert.NoError(err, t.constraint)
+		version, err := validator.ParseSemVer(t.version)
+		assert.NoError(err, t.version)
+		assert.Equal(t.expected, constraint.Check(version), "%s %s", t.constraint, t.version)
+	}
+}
+
+func TestIsGoModuleVersion(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []testCase{
+		{"v1.2.3", true},
+		{"v0.0.0-20191109021931-daa7c04131f5", true},
+		{"v1.2.4-0.20191109021931-daa7c04131f5", true},
+		{"v1.2.3-pre.0.20191109021931-daa7c04131f5", true},
+		{"v2.0.0+incompatible", true},
+		{"v1.0.0-rc.1", true},
+		{"1.2.3", false},
+		{"v1.2", false},
+		{"v1.0.0+incompatible", false},
+		{"v2.0.0+build", false},
+		{"v1.2.3-20191109021931-daa7c04131f5", false},
+		{"v0.0.0-20191309021931-daa7c04131f5", false},
+		{"v0.0.0-20191109021931-DAA7C04131F5", false},
+		{"", false},
+	}
+
+	for _, t := range testCases {
+		actual := validator.IsGoModuleVersion(t.param)
+		assert.Equal(t.expected, actual, t.param)
+	}
+}
@@ -0,0 +1,165 @@
+package validator
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"unicode/utf8"
+)
+
+// maxDataURIHeaderLength bounds the part of a data URI before the comma that
+// ValidateDataURIReader buffers.
+const maxDataURIHeaderLength = 1024
+
+// ValidateASCIIReader reads r to the end and reports the offset of the first
+// non-ASCII byte as a *SyntaxError. It is the streaming form of IsASCII. Read
+// errors other than io.EOF are returned as is.
+func ValidateASCIIReader(r io.Reader) error {
+	return scanBytes(r, func(c byte) string {
+		if c >= 0x80 {
+			return "non-ASCII byte"
+		}
+		return ""
+	})
+}
+
+// ValidatePrintableASCIIReader is the streaming form of IsPrintableASCII.
+func ValidatePrintableASCIIReader(r io.Reader) error {
+	return scanBytes(r, func(c byte) string {
+		if c < 0x20 || c > 0x7e {
+			return "non-printable or non-ASCII byte"
+		}
+		return ""
+	})
+}
+
+// HasMultibyteCharReader is the streaming form of HasMultibyteChar. It reads r
+// to the end and reports whether it contains a non-ASCII character, or the
+// offset of the first invalid UTF-8 sequence as a *SyntaxError.
+func HasMultibyteCharReader(r io.Reader) (bool, error) {
+	reader := bufio.NewReader(r)
+
+	multibyte := false
+	var offset int64
+	for {
+		char, size, err := reader.ReadRune()
+		if errors.Is(err, io.EOF) {
+			return multibyte, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		if char == utf8.RuneError && size == 1 {
+			return false, &SyntaxError{Offset: offset, Reason: "invalid UTF-8"}
+		}
+		if size > 1 {
+			multibyte = true
+		}
+		offset += int64(size)
+	}
+}
+
+// ValidateBase64Reader is the streaming form of IsBase64: it reads r to the
+// end in constant memory and reports the offset of the first byte that makes
+// the input invalid standard base64 as a *SyntaxError. Truncated input is
+// reported at the offset of the end of the input.
+func ValidateBase64Reader(r io.Reader) error {
+	return validateBase64Stream(bufio.NewReader(r), 0, false)
+}
+
+// ValidateBase64URLReader is the streaming form of IsBase64URL.
+func ValidateBase64URLReader(r io.Reader) error {
+	return validateBase64Stream(bufio.NewReader(r), 0, true)
+}
+
+// ValidateDataURIReader is the streaming form of IsDataURI. The media type
+// and parameters before the comma may be at most 1024 bytes long; the base64
+// payload is validated in constant memory.
+func ValidateDataURIReader(r io.Reader) error {
+	reader := bufio.NewReader(r)
+
+	header := make([]byte, 0, 64)
+	for {
+		c, err := reader.ReadByte()
+		if errors.Is(err, io.EOF) {
+			return &SyntaxError{Offset: int64(len(header)), Reason: "missing comma after data URI header"}
+		}
+		if err != nil {
+			return err
+		}
+		if c == ',' {
+			break
+		}
+		if len(header) == maxDataURIHeaderLength {
+			return &SyntaxError{Offset: int64(len(header)), Reason: "data URI header too long"}
+		}
+		header = append(header, c)
+	}
+
+	if !dataURIRegex.MatchString(string(header)) {
+		return &SyntaxError{Offset: 0, Reason: "invalid data URI header"}
+	}
+
+	return validateBase64Stream(reader, int64(len(header))+1, false)
+}
+
+// scanBytes reads r to the end, calling check for every byte until it
+// returns a reason for rejecting it.
+func scanBytes(r io.Reader, check func(c byte) string) error {
+	buf := make([]byte, 32*1024)
+
+	var offset int64
+	for {
+		n, err := r.Read(buf)
+		for i, c := range buf[:n] {
+			if reason := check(c); reason != "" {
+				return &SyntaxError{Offset: offset + int64(i), Reason: reason}
+			}
+		}
+		offset += int64(n)
+
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// validateBase64Stream validates the rest of reader as base64. offset is the
+// position of the first byte of reader in the whole input.
+func validateBase64Stream(reader *bufio.Reader, offset int64, url bool) error {
+	var n int64
+	padding := 0
+	for {
+		c, err := reader.ReadByte()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case c == '=':
+			// Padding may only fill the last two positions of the final
+			// quantum, after at least two data characters.
+			if n%4 < 2 {
+				return &SyntaxError{Offset: offset + n, Reason: "unexpected padding"}
+			}
+			padding++
+		case padding > 0:
+			return &SyntaxError{Offset: offset + n, Reason: "data after padding"}
+		case !isBase64Byte(c, url):
+			return &SyntaxError{Offset: offset + n, Reason: "invalid base64 character"}
+		}
+		n++
+	}
+
+	if n == 0 || n%4 != 0 {
+		return &SyntaxError{Offset: offset + n, Reason: "truncated base64 input"}
+	}
+
+	return nil
+}
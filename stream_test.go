@@ -0,0 +1,116 @@
+package validator_test
+
+import (
+	"errors"
+	validator "github.com/MrWormHole/simple-validator"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestValidateBase64Reader(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []string{
+		"", "QQ==", "QUI=", "QUJD", "QUJDRA==", "Q===", "QQ=", "QQ=A", "QQ==QQ==",
+		"=QQQ", "QU JD", "QUJD\n", "a-_b", "a+/b", "Zm9vYmFy", "Zm9vYmE=", "Zm9vYg==",
+	}
+
+	for _, param := range testCases {
+		err := validator.ValidateBase64Reader(iotest.OneByteReader(strings.NewReader(param)))
+		assert.Equal(validator.IsBase64(param), err == nil, param)
+
+		err = validator.ValidateBase64URLReader(strings.NewReader(param))
+		assert.Equal(validator.IsBase64URL(param), err == nil, param)
+	}
+
+	err := validator.ValidateBase64Reader(strings.NewReader("QUJD QUJD"))
+	var syntaxErr *validator.SyntaxError
+	assert.ErrorAs(err, &syntaxErr)
+	assert.Equal(int64(4), syntaxErr.Offset)
+	assert.EqualError(err, "syntax error at offset 4: invalid base64 character")
+
+	err = validator.ValidateBase64Reader(strings.NewReader("QUJDQQ"))
+	assert.EqualError(err, "syntax error at offset 6: truncated base64 input")
+
+	err = validator.ValidateBase64Reader(strings.NewReader("QQ==QQ=="))
+	assert.EqualError(err, "syntax error at offset 4: data after padding")
+}
+
+func TestValidateBase64ReaderLargeInput(t *testing.T) {
+	assert := assert.New(t)
+
+	payload := strings.Repeat("QUJD", 1<<20)
+	assert.NoError(validator.ValidateBase64Reader(strings.NewReader(payload)))
+
+	err := validator.ValidateBase64Reader(strings.NewReader(payload + "!"))
+	var syntaxErr *validator.SyntaxError
+	assert.ErrorAs(err, &syntaxErr)
+	assert.Equal(int64(len(payload)), syntaxErr.Offset)
+}
+
+func TestValidateASCIIReader(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []string{"", "abc", "tab\there\x00\x7f", "héllo", "\xff", "ｆｏｏ"}
+
+	for _, param := range testCases {
+		err := validator.ValidateASCIIReader(iotest.HalfReader(strings.NewReader(param)))
+		assert.Equal(validator.IsASCII(param), err == nil, param)
+
+		err = validator.ValidatePrintableASCIIReader(iotest.HalfReader(strings.NewReader(param)))
+		assert.Equal(validator.IsPrintableASCII(param), err == nil, param)
+	}
+
+	err := validator.ValidateASCIIReader(strings.NewReader(strings.Repeat("a", 100000) + "é"))
+	assert.EqualError(err, "syntax error at offset 100000: non-ASCII byte")
+
+	err = validator.ValidatePrintableASCIIReader(strings.NewReader("abc\ndef"))
+	assert.EqualError(err, "syntax error at offset 3: non-printable or non-ASCII byte")
+
+	failure := errors.New("connection reset")
+	err = validator.ValidateASCIIReader(io.MultiReader(strings.NewReader("abc"), iotest.ErrReader(failure)))
+	assert.ErrorIs(err, failure)
+}
+
+func TestHasMultibyteCharReader(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []string{"", "abc", "ひらがな", "abc日本", "abc\xff", "\xef\xbf\xbd"}
+
+	for _, param := range testCases {
+		actual, err := validator.HasMultibyteCharReader(iotest.OneByteReader(strings.NewReader(param)))
+		assert.Equal(validator.HasMultibyteChar(param), actual && err == nil, param)
+	}
+
+	_, err := validator.HasMultibyteCharReader(strings.NewReader("日本\xffabc"))
+	assert.EqualError(err, "syntax error at offset 6: invalid UTF-8")
+}
+
+func TestValidateDataURIReader(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []string{
+		"data:text/plain;base64,SGVsbG8sIFdvcmxkIQ==",
+		"data:image/png;base64,iVBORw0KGgo=",
+		"data:,QUJD",
+		"data:text/plain;base64,SGVsbG8sIFdvcmxkIQ",
+		"data:text/plain;base64,SGVsbG8s,IFdvcmxkIQ==",
+		"text/plain;base64,SGVsbG8sIFdvcmxkIQ==",
+		"data:text/plain;base64",
+		"",
+	}
+
+	for _, param := range testCases {
+		err := validator.ValidateDataURIReader(iotest.OneByteReader(strings.NewReader(param)))
+		assert.Equal(validator.IsDataURI(param), err == nil, param)
+	}
+
+	err := validator.ValidateDataURIReader(strings.NewReader("data:text/plain;base64,SGVs*bG8="))
+	assert.EqualError(err, "syntax error at offset 27: invalid base64 character")
+
+	err = validator.ValidateDataURIReader(strings.NewReader("data:" + strings.Repeat("x", 2000) + ",QUJD"))
+	assert.EqualError(err, "syntax error at offset 1024: data URI header too long")
+}
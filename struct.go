@@ -0,0 +1,786 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// ErrNotStruct is returned by ValidateStruct for values that are not a struct
+// or a pointer to one.
+var ErrNotStruct = errors.New("validate: not a struct")
+
+// FieldError describes a struct field that failed a rule of its validate tag.
+// Field is the path of the field from the validated struct, such as
+// "billing.vat_id" or "items[2].sku", using json names where a field has one.
+type FieldError struct {
+	Field   string
+	Rule    string
+	Param   string
+	Message string
+}
+
+func (e *FieldError) Error() string {
+	if e.Field == "" {
+		return e.Message
+	}
+
+	return e.Field + " " + e.Message
+}
+
+// Is reports whether target is ErrInvalid.
+func (e *FieldError) Is(target error) bool {
+	return target == ErrInvalid
+}
+
+// ValidationErrors is returned by ValidateStruct and lists every invalid
+// field in declaration order, with at most one error per field.
+type ValidationErrors []*FieldError
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// Is reports whether target is ErrInvalid.
+func (e ValidationErrors) Is(target error) bool {
+	return target == ErrInvalid
+}
+
+// CycleError is returned by ValidateStruct for a value that refers back to
+// itself through pointers or slices, such as a linked list whose last node
+// points to the first. Field is the path at which the cycle closes.
+type CycleError struct {
+	Field string
+}
+
+func (e *CycleError) Error() string {
+	return "validate: cycle at " + e.Field
+}
+
+// TagError describes a validate tag that cannot be applied to its field, such
+// as an unknown rule or a reference to a field that does not exist.
+type TagError struct {
+	Field  string
+	Tag    string
+	Reason string
+}
+
+func (e *TagError) Error() string {
+	return fmt.Sprintf("validate: invalid tag %q on %s: %s", e.Tag, e.Field, e.Reason)
+}
+
+var (
+	tagValidationsMu sync.RWMutex
+	tagValidations   = map[string]func(string) bool{
+		"alpha":            IsAlpha,
+		"alphanum":         IsAlphaNumeric,
+		"alphaunicode":     IsAlphaUnicode,
+		"alphanumunicode":  IsAlphaUnicodeNumeric,
+		"ascii":            IsASCII,
+		"base64":           IsBase64,
+		"base64url":        IsBase64URL,
+		"bcp47":            IsBCP47,
+		"cron":             IsCron,
+		"datauri":          IsDataURI,
+		"duration":         IsGoDuration,
+		"email":            IsEmail,
+		"eth_addr":         IsETHAddress,
+		"fqdn":             IsDomainName,
+		"hexadecimal":      IsHexadecimal,
+		"hexcolor":         IsHexcolor,
+		"hsl":              IsHSL,
+		"hsla":             IsHSLA,
+		"html":             IsHTML,
+		"html_encoded":     IsHTMLEncoded,
+		"iban":             IsIBAN,
+		"ip":               IsIPAddress,
+		"isbn10":           IsISBN10,
+		"isbn13":           IsISBN13,
+		"iso3166_alpha2":   IsISO3166Alpha2,
+		"iso3166_alpha3":   IsISO3166Alpha3,
+		"iso4217":          IsISO4217,
+		"iso639":           IsISO639,
+		"iso8601":          IsISO8601,
+		"iso8601_duration": IsISO8601Duration,
+		"latitude":         IsLatitude,
+		"longitude":        IsLongitude,
+		"number":           IsNumber,
+		"numeric":          IsNumeric,
+		"printascii":       IsPrintableASCII,
+		"rfc3339":          IsRFC3339,
+		"rgb":              IsRGB,
+		"rgba":             IsRGBA,
+		"rrule":            IsRRule,
+		"semver":           IsSemVer,
+		"timezone":         IsIANATimezone,
+		"url_encoded":      IsURLEncoded,
+		"uuid":             IsUUID,
+		"uuid3":            IsUUID3,
+		"uuid4":            IsUUID4,
+		"uuid5":            IsUUID5,
+	}
+
+	timeType = reflect.TypeOf(time.Time{})
+)
+
+// RegisterValidation makes fn available to validate tags under name, replacing
+// any validation registered under that name before. Registered validations
+// apply to string fields and take no parameter. The names of the structural
+// rules, such as required or eqfield, cannot be overridden.
+func RegisterValidation(name string, fn func(string) bool) {
+	tagValidationsMu.Lock()
+	defer tagValidationsMu.Unlock()
+
+	tagValidations[name] = fn
+}
+
+// Validations returns the validations available to validate tags by name,
+// including those added with RegisterValidation. The returned map is a copy.
+func Validations() map[string]func(string) bool {
+	tagValidationsMu.RLock()
+	defer tagValidationsMu.RUnlock()
+
+	validations := make(map[string]func(string) bool, len(tagValidations))
+	for name, fn := range tagValidations {
+		validations[name] = fn
+	}
+
+	return validations
+}
+
+func lookupValidation(name string) (func(string) bool, bool) {
+	tagValidationsMu.RLock()
+	defer tagValidationsMu.RUnlock()
+
+	fn, ok := tagValidations[name]
+	return fn, ok
+}
+
+// ValidateStruct checks the exported fields of the struct v, or of the struct
+// v points to, against their validate tags, descending into nested structs,
+// pointers to structs and slices of structs. It returns ValidationErrors
+// listing every invalid field, a *TagError for a malformed tag, or nil.
+//
+// A tag is a comma-separated list of rules applied in order; the first rule a
+// field fails is reported and the rest are skipped:
+//
+//	type Signup struct {
+//		Email           string    `json:"email" validate:"required,email"`
+//		Password        string    `json:"password" validate:"required,min=12"`
+//		ConfirmPassword string    `json:"confirm_password" validate:"eqfield=Password"`
+//		Country         string    `json:"country" validate:"required,iso3166_alpha2"`
+//		VATID           string    `json:"vat_id" validate:"required_if=Country AT|BE|DE|FR"`
+//		StartDate       time.Time `json:"start_date"`
+//		EndDate         time.Time `json:"end_date" validate:"gtfield=StartDate"`
+//	}
+//
+// The rules are:
+//
+//	required                 the field is not its zero value, a nil pointer
+//	                         or an empty slice or map
+//	omitempty                skip the remaining rules when the field is empty
+//	required_if=F v [G w]    required when F is v and G is w; v|x accepts
+//	                         either value
+//	required_unless=F v      required unless F is v, with the same syntax
+//	required_with=F [G]      required when F or G is not empty
+//	excluded_with=F [G]      empty when F or G is not empty
+//	eqfield=F, nefield=F     equal to, or different from, field F
+//	gtfield=F, gtefield=F,   greater than, at least, less than or at most
+//	ltfield=F, ltefield=F    field F; numbers, strings and times only
+//	min=n, max=n, len=n      the value of a number, or the length in
+//	                         characters of a string or in elements of a
+//	                         slice or map
+//	oneof=a b c              one of the space-separated values
+//
+// and the validations registered with RegisterValidation, which include
+// email, uuid, alpha, numeric, iso3166_alpha2 and most other validators of
+// the package. Fields F are named by Go or json name and resolved in the
+// struct containing the tag first and then from v, with dots descending into
+// nested structs, as in required_with=Address.Street.
+//
+// Embedded structs are not flattened: their fields are validated and
+// referred to through the name of their type, as in Address.city for an
+// embedded Address, and embedded unexported types are skipped like other
+// unexported fields. Unknown rules are reported even when an earlier rule such
+// as omitempty skips the rest of the tag, and a value referring back to
+// itself, such as a circular linked list, is reported as a *CycleError.
+func ValidateStruct(v any) error {
+	value := reflect.ValueOf(v)
+	for value.Kind() == reflect.Ptr && !value.IsNil() {
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return ErrNotStruct
+	}
+
+	walker := &structWalker{root: value}
+	if err := walker.walkStruct(value, ""); err != nil {
+		return err
+	}
+	if len(walker.errors) > 0 {
+		return walker.errors
+	}
+
+	return nil
+}
+
+// ValidateValue checks a single value, such as a string read from a file,
+// against the rules of a validate tag. It returns a *FieldError with an empty
+// Field for the first rule the value fails, a *TagError for a malformed tag,
+// or nil. Rules referring to other fields cannot be used.
+//
+//	err := validator.ValidateValue(row["email"], "required,email")
+func ValidateValue(value any, tag string) error {
+	walker := &structWalker{}
+	if err := walker.checkField(reflect.Value{}, reflect.ValueOf(value), "", tag); err != nil {
+		return err
+	}
+	if len(walker.errors) > 0 {
+		return walker.errors[0]
+	}
+
+	return nil
+}
+
+type structWalker struct {
+	root   reflect.Value
+	errors ValidationErrors
+	// visiting holds the pointers and slices being walked, to detect
+	// cycles.
+	visiting map[visit]struct{}
+}
+
+// visit identifies a pointer or slice by its address, type and, for slices,
+// length, as encoding/json does.
+type visit struct {
+	ptr    uintptr
+	typ    reflect.Type
+	length int
+}
+
+func (w *structWalker) walkStruct(value reflect.Value, path string) error {
+	structType := value.Type()
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		tag := field.Tag.Get("validate")
+		if field.PkgPath != "" || tag == "-" {
+			continue
+		}
+
+		fieldPath := fieldName(field)
+		if path != "" {
+			fieldPath = path + "." + fieldPath
+		}
+		if tag != "" {
+			if err := w.checkField(value, value.Field(i), fieldPath, tag); err != nil {
+				return err
+			}
+		}
+		if err := w.walkValue(value.Field(i), fieldPath); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// walkValue descends into the structs held by value.
+func (w *structWalker) walkValue(value reflect.Value, path string) error {
+	for value.Kind() == reflect.Interface && !value.IsNil() {
+		value = value.Elem()
+	}
+	if (value.Kind() == reflect.Ptr || value.Kind() == reflect.Slice) && !value.IsNil() {
+		key := visit{ptr: value.Pointer(), typ: value.Type()}
+		if value.Kind() == reflect.Slice {
+			key.length = value.Len()
+		}
+		if _, ok := w.visiting[key]; ok {
+			return &CycleError{Field: path}
+		}
+		if w.visiting == nil {
+			w.visiting = make(map[visit]struct{})
+		}
+		w.visiting[key] = struct{}{}
+		defer delete(w.visiting, key)
+	}
+
+	value, ok := indirect(value)
+	if !ok {
+		return nil
+	}
+
+	switch value.Kind() {
+	case reflect.Struct:
+		if value.Type() == timeType {
+			return nil
+		}
+		return w.walkStruct(value, path)
+	case reflect.Slice, reflect.Array:
+		elementType := value.Type().Elem()
+		for elementType.Kind() == reflect.Ptr {
+			elementType = elementType.Elem()
+		}
+		if elementType.Kind() != reflect.Struct || elementType == timeType {
+			return nil
+		}
+		for i := 0; i < value.Len(); i++ {
+			if err := w.walkValue(value.Index(i), path+"["+strconv.Itoa(i)+"]"); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (w *structWalker) checkField(parent reflect.Value, value reflect.Value, path string, tag string) error {
+	rules := strings.Split(tag, ",")
+	for _, rule := range rules {
+		// Unknown rules are reported even when an earlier rule stops the
+		// checks, so that a misspelled tag fails whatever the value.
+		name, _, _ := strings.Cut(rule, "=")
+		if _, ok := lookupValidation(name); !ok && !isStructuralRule(name) {
+			return &TagError{Field: path, Tag: rule, Reason: "unknown rule"}
+		}
+	}
+
+	empty := isEmptyValue(value)
+	value, present := indirect(value)
+
+	for _, rule := range rules {
+		name, param, _ := strings.Cut(rule, "=")
+		fail := func(message string) error {
+			w.errors = append(w.errors, &FieldError{Field: path, Rule: name, Param: param, Message: message})
+			return nil
+		}
+		tagError := func(reason string) error {
+			return &TagError{Field: path, Tag: rule, Reason: reason}
+		}
+
+		switch name {
+		case "omitempty":
+			if empty {
+				return nil
+			}
+			continue
+		case "required":
+			if empty {
+				return fail("is required")
+			}
+			continue
+		case "required_if", "required_unless":
+			matches, err := w.matchesFields(parent, param)
+			if err != nil {
+				return tagError(err.Error())
+			}
+			if empty && matches == (name == "required_if") {
+				return fail("is required")
+			}
+			continue
+		case "required_with", "excluded_with":
+			others, err := w.presentFields(parent, param)
+			if err != nil {
+				return tagError(err.Error())
+			}
+			if others == "" {
+				continue
+			}
+			if name == "required_with" && empty {
+				return fail("is required when " + others + " is set")
+			}
+			if name == "excluded_with" && !empty {
+				return fail("must be empty when " + others + " is set")
+			}
+			continue
+		}
+
+		if !present {
+			// A nil pointer has no value to check against the
+			// remaining rules; required rejects it when it matters.
+			return nil
+		}
+
+		switch name {
+		case "eqfield", "nefield", "gtfield", "gtefield", "ltfield", "ltefield":
+			other, ok := w.resolveField(parent, param)
+			if !ok {
+				return tagError("unknown field " + param)
+			}
+			other, otherPresent := indirect(other)
+			if !otherPresent {
+				if name == "nefield" {
+					continue
+				}
+				return fail("requires " + param + " to be set")
+			}
+			if message, err := compareFields(name, value, other, param); err != nil {
+				return tagError(err.Error())
+			} else if message != "" {
+				return fail(message)
+			}
+		case "min", "max", "len":
+			if message, err := checkSize(name, value, param); err != nil {
+				return tagError(err.Error())
+			} else if message != "" {
+				return fail(message)
+			}
+		case "oneof":
+			if !containsString(strings.Fields(param), formatValue(value)) {
+				return fail("must be one of " + param)
+			}
+		default:
+			fn, _ := lookupValidation(name)
+			if value.Kind() != reflect.String {
+				return tagError("rule applies to strings only")
+			}
+			if !fn(value.String()) {
+				return fail("must be a valid " + name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// isStructuralRule reports whether name is a rule implemented by checkField
+// rather than a registered validation.
+func isStructuralRule(name string) bool {
+	switch name {
+	case "omitempty", "required", "required_if", "required_unless", "required_with", "excluded_with",
+		"eqfield", "nefield", "gtfield", "gtefield", "ltfield", "ltefield", "min", "max", "len", "oneof":
+		return true
+	}
+
+	return false
+}
+
+// matchesFields reports whether every field of a required_if parameter such
+// as "Country AT|BE Type business" has one of its listed values.
+func (w *structWalker) matchesFields(parent reflect.Value, param string) (bool, error) {
+	words := strings.Fields(param)
+	if len(words) == 0 || len(words)%2 != 0 {
+		return false, errors.New("expected field and value pairs")
+	}
+
+	matches := true
+	for i := 0; i < len(words); i += 2 {
+		other, ok := w.resolveField(parent, words[i])
+		if !ok {
+			return false, errors.New("unknown field " + words[i])
+		}
+		formatted := ""
+		if other, present := indirect(other); present {
+			formatted = formatValue(other)
+		}
+		if !containsString(strings.Split(words[i+1], "|"), formatted) {
+			matches = false
+		}
+	}
+
+	return matches, nil
+}
+
+// presentFields returns the first non-empty field of a required_with
+// parameter, or "" when all of them are empty.
+func (w *structWalker) presentFields(parent reflect.Value, param string) (string, error) {
+	names := strings.Fields(param)
+	if len(names) == 0 {
+		return "", errors.New("expected field names")
+	}
+
+	for _, name := range names {
+		other, ok := w.resolveField(parent, name)
+		if !ok {
+			return "", errors.New("unknown field " + name)
+		}
+		if !isEmptyValue(other) {
+			return name, nil
+		}
+	}
+
+	return "", nil
+}
+
+// resolveField finds the field at a dotted path in parent or, failing that,
+// in the root struct.
+func (w *structWalker) resolveField(parent reflect.Value, path string) (reflect.Value, bool) {
+	if value, ok := lookupField(parent, path); ok {
+		return value, true
+	}
+
+	return lookupField(w.root, path)
+}
+
+func lookupField(value reflect.Value, path string) (reflect.Value, bool) {
+	for _, name := range strings.Split(path, ".") {
+		value, _ = indirect(value)
+		if value.Kind() != reflect.Struct {
+			return reflect.Value{}, false
+		}
+
+		found := false
+		structType := value.Type()
+		for i := 0; i < structType.NumField(); i++ {
+			field := structType.Field(i)
+			if field.PkgPath == "" && (field.Name == name || fieldName(field) == name) {
+				value = value.Field(i)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return reflect.Value{}, false
+		}
+	}
+
+	return value, true
+}
+
+// fieldName returns the json name of a field, or its Go name.
+func fieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" || name == "-" {
+		return field.Name
+	}
+
+	return name
+}
+
+// indirect follows pointers and interfaces, reporting false for nil.
+func indirect(value reflect.Value) (reflect.Value, bool) {
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return value, false
+		}
+		value = value.Elem()
+	}
+
+	return value, value.IsValid()
+}
+
+func isEmptyValue(value reflect.Value) bool {
+	value, ok := indirect(value)
+	if !ok {
+		return true
+	}
+
+	switch value.Kind() {
+	case reflect.Slice, reflect.Map:
+		return value.Len() == 0
+	}
+
+	return value.IsZero()
+}
+
+// formatValue formats a string, number or boolean as it would be written in a
+// tag parameter.
+func formatValue(value reflect.Value) string {
+	if value.Kind() == reflect.String {
+		return value.String()
+	}
+
+	return fmt.Sprint(value.Interface())
+}
+
+// compareFields applies a cross-field rule and returns a message when value
+// fails it. NaN is neither equal to nor ordered with any number, so it fails
+// every rule but nefield.
+func compareFields(rule string, value reflect.Value, other reflect.Value, otherName string) (string, error) {
+	if rule == "eqfield" || rule == "nefield" {
+		equal := false
+		if value.Type() == other.Type() {
+			equal = reflect.DeepEqual(value.Interface(), other.Interface())
+		}
+		if cmp, ok := compareValues(value, other); ok {
+			equal = cmp == 0
+		}
+		if rule == "eqfield" && !equal {
+			return "must equal " + otherName, nil
+		}
+		if rule == "nefield" && equal {
+			return "must differ from " + otherName, nil
+		}
+		return "", nil
+	}
+
+	cmp, ok := compareValues(value, other)
+	if !ok {
+		return "", fmt.Errorf("cannot compare %s with %s", value.Type(), other.Type())
+	}
+
+	switch {
+	case rule == "gtfield" && cmp != 1:
+		return "must be after " + otherName, nil
+	case rule == "gtefield" && cmp != 0 && cmp != 1:
+		return "must not be before " + otherName, nil
+	case rule == "ltfield" && cmp != -1:
+		return "must be before " + otherName, nil
+	case rule == "ltefield" && cmp > 0:
+		return "must not be after " + otherName, nil
+	}
+
+	return "", nil
+}
+
+// unordered is the result of comparing NaN with a number.
+const unordered = 2
+
+// compareValues orders two numbers, strings or times as -1, 0 or 1, or
+// unordered when a number is NaN, reporting false for values that cannot be
+// compared.
+func compareValues(a reflect.Value, b reflect.Value) (int, bool) {
+	if a.Type() == timeType && b.Type() == timeType {
+		x, y := a.Interface().(time.Time), b.Interface().(time.Time)
+		switch {
+		case x.Before(y):
+			return -1, true
+		case x.After(y):
+			return 1, true
+		}
+		return 0, true
+	}
+
+	if a.Kind() == reflect.String && b.Kind() == reflect.String {
+		return strings.Compare(a.String(), b.String()), true
+	}
+
+	return compareNumbers(a, b)
+}
+
+// compareNumbers compares two numbers exactly: integers as int64 or uint64,
+// and as float64 only when one of them is a float.
+func compareNumbers(a reflect.Value, b reflect.Value) (int, bool) {
+	kindA, ok := numberKind(a)
+	if !ok {
+		return 0, false
+	}
+	kindB, ok := numberKind(b)
+	if !ok {
+		return 0, false
+	}
+
+	switch {
+	case kindA == reflect.Float64 || kindB == reflect.Float64:
+		x, y := floatValue(a), floatValue(b)
+		if math.IsNaN(x) || math.IsNaN(y) {
+			return unordered, true
+		}
+		return compareOrdered(x, y), true
+	case kindA == reflect.Int && kindB == reflect.Int:
+		return compareOrdered(a.Int(), b.Int()), true
+	case kindA == reflect.Uint && kindB == reflect.Uint:
+		return compareOrdered(a.Uint(), b.Uint()), true
+	case kindA == reflect.Int:
+		if a.Int() < 0 {
+			return -1, true
+		}
+		return compareOrdered(uint64(a.Int()), b.Uint()), true
+	}
+
+	if b.Int() < 0 {
+		return 1, true
+	}
+	return compareOrdered(a.Uint(), uint64(b.Int())), true
+}
+
+func compareOrdered[T Ordered](x T, y T) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+
+	return 0
+}
+
+// numberKind reports whether value is a number, and whether it is a signed
+// integer, an unsigned integer or a float as reflect.Int, reflect.Uint or
+// reflect.Float64.
+func numberKind(value reflect.Value) (reflect.Kind, bool) {
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return reflect.Int, true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return reflect.Uint, true
+	case reflect.Float32, reflect.Float64:
+		return reflect.Float64, true
+	}
+
+	return reflect.Invalid, false
+}
+
+func floatValue(value reflect.Value) float64 {
+	switch kind, _ := numberKind(value); kind {
+	case reflect.Int:
+		return float64(value.Int())
+	case reflect.Uint:
+		return float64(value.Uint())
+	}
+
+	return value.Float()
+}
+
+// parseLimit parses the parameter of a min, max or len rule as an int64, a
+// uint64 or, failing both, a float64 other than NaN.
+func parseLimit(param string) (reflect.Value, bool) {
+	if n, err := strconv.ParseInt(param, 10, 64); err == nil {
+		return reflect.ValueOf(n), true
+	}
+	if n, err := strconv.ParseUint(param, 10, 64); err == nil {
+		return reflect.ValueOf(n), true
+	}
+	if n, err := strconv.ParseFloat(param, 64); err == nil && !math.IsNaN(n) {
+		return reflect.ValueOf(n), true
+	}
+
+	return reflect.Value{}, false
+}
+
+// checkSize applies a min, max or len rule and returns a message when value
+// fails it.
+func checkSize(rule string, value reflect.Value, param string) (string, error) {
+	limit, ok := parseLimit(param)
+	if !ok {
+		return "", errors.New("expected a number")
+	}
+
+	size, unit := value, ""
+	switch value.Kind() {
+	case reflect.String:
+		size, unit = reflect.ValueOf(int64(utf8.RuneCountInString(value.String()))), " characters long"
+	case reflect.Slice, reflect.Map, reflect.Array:
+		size, unit = reflect.ValueOf(int64(value.Len())), " elements"
+	default:
+		if _, ok := numberKind(value); !ok || rule == "len" {
+			return "", fmt.Errorf("rule does not apply to %s", value.Type())
+		}
+	}
+
+	prefix := "must be "
+	if unit == " elements" {
+		prefix = "must have "
+	}
+	cmp, _ := compareNumbers(size, limit)
+	switch {
+	case rule == "min" && cmp != 0 && cmp != 1:
+		return prefix + "at least " + param + unit, nil
+	case rule == "max" && cmp > 0:
+		return prefix + "at most " + param + unit, nil
+	case rule == "len" && cmp != 0:
+		return prefix + "exactly " + param + unit, nil
+	}
+
+	return "", nil
+}
@@ -0,0 +1,396 @@
+package validator_test
+
+import (
+	"errors"
+	validator "github.com/MrWormHole/simple-validator"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type address struct {
+	Street  string `json:"street"`
+	City    string `json:"city" validate:"required_with=Street"`
+	Country string `json:"country" validate:"required,iso3166_alpha2"`
+}
+
+type lineItem struct {
+	SKU      string `json:"sku" validate:"required,alphanum"`
+	Quantity int    `json:"quantity" validate:"min=1,max=100"`
+}
+
+type signup struct {
+	Email           string     `json:"email" validate:"required,email"`
+	Password        string     `json:"password" validate:"required,min=8"`
+	ConfirmPassword string     `json:"confirm_password" validate:"eqfield=Password"`
+	Plan            string     `json:"plan" validate:"oneof=free pro"`
+	Seats           int        `json:"seats" validate:"required_if=Plan pro,omitempty,min=2"`
+	VATID           string     `json:"vat_id" validate:"required_if=Address.Country AT|BE|DE|FR,excluded_with=TaxExempt"`
+	TaxExempt       *bool      `json:"tax_exempt"`
+	StartDate       time.Time  `json:"start_date"`
+	EndDate         time.Time  `json:"end_date" validate:"gtfield=StartDate"`
+	Address         address    `json:"address"`
+	Billing         *address   `json:"billing"`
+	Items           []lineItem `json:"items" validate:"max=2"`
+	Tags            []string   `json:"tags" validate:"omitempty,min=1"`
+	internal        string     `validate:"required"`
+}
+
+func validSignup() signup {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	return signup{
+		Email:           "john.doe@example.com",
+		Password:        "correct horse",
+		ConfirmPassword: "correct horse",
+		Plan:            "pro",
+		Seats:           5,
+		VATID:           "DE123456789",
+		StartDate:       start,
+		EndDate:         start.AddDate(1, 0, 0),
+		Address:         address{Street: "Unter den Linden 1", City: "Berlin", Country: "DE"},
+		Items:           []lineItem{{SKU: "A1", Quantity: 1}},
+	}
+}
+
+func fieldErrors(err error) map[string]string {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return nil
+	}
+
+	fields := map[string]string{}
+	for _, fieldErr := range validationErrors {
+		fields[fieldErr.Field] = fieldErr.Message
+	}
+	return fields
+}
+
+func TestValidateStruct(t *testing.T) {
+	assert := assert.New(t)
+
+	s := validSignup()
+	assert.NoError(validator.ValidateStruct(s))
+	assert.NoError(validator.ValidateStruct(&s))
+
+	s = validSignup()
+	s.Email = "john.doe@"
+	s.ConfirmPassword = "correct horse battery"
+	s.Plan = "enterprise"
+	s.EndDate = s.StartDate
+	err := validator.ValidateStruct(s)
+	assert.ErrorIs(err, validator.ErrInvalid)
+	assert.Equal(map[string]string{
+		"email":            "must be a valid email",
+		"confirm_password": "must equal Password",
+		"plan":             "must be one of free pro",
+		"end_date":         "must be after StartDate",
+	}, fieldErrors(err))
+	assert.Equal("email must be a valid email; confirm_password must equal Password; "+
+		"plan must be one of free pro; end_date must be after StartDate", err.Error())
+
+	var validationErrors validator.ValidationErrors
+	assert.True(errors.As(err, &validationErrors))
+	assert.Equal("email", validationErrors[0].Rule)
+	assert.ErrorIs(validationErrors[0], validator.ErrInvalid)
+
+	s = validSignup()
+	s.Password = "short"
+	s.ConfirmPassword = "short"
+	assert.Equal(map[string]string{"password": "must be at least 8 characters long"}, fieldErrors(validator.ValidateStruct(s)))
+}
+
+func TestValidateStructConditionalRules(t *testing.T) {
+	assert := assert.New(t)
+
+	s := validSignup()
+	s.Seats = 0
+	assert.Equal(map[string]string{"seats": "is required"}, fieldErrors(validator.ValidateStruct(s)))
+
+	s.Plan = "free"
+	assert.NoError(validator.ValidateStruct(s))
+
+	s.Seats = 1
+	assert.Equal(map[string]string{"seats": "must be at least 2"}, fieldErrors(validator.ValidateStruct(s)))
+
+	s = validSignup()
+	s.VATID = ""
+	assert.Equal(map[string]string{"vat_id": "is required"}, fieldErrors(validator.ValidateStruct(s)))
+
+	s.Address.Country = "US"
+	assert.NoError(validator.ValidateStruct(s))
+
+	exempt := true
+	s = validSignup()
+	s.TaxExempt = &exempt
+	assert.Equal(map[string]string{"vat_id": "must be empty when TaxExempt is set"}, fieldErrors(validator.ValidateStruct(s)))
+
+	s.VATID = ""
+	s.Address.Country = "FR"
+	assert.Equal(map[string]string{"vat_id": "is required"}, fieldErrors(validator.ValidateStruct(s)))
+}
+
+func TestValidateStructNestedPaths(t *testing.T) {
+	assert := assert.New(t)
+
+	s := validSignup()
+	s.Address.City = ""
+	s.Billing = &address{Country: "Germany"}
+	s.Items = []lineItem{{SKU: "A1", Quantity: 1}, {SKU: "B-2", Quantity: 0}}
+	s.Tags = []string{}
+	assert.Equal(map[string]string{
+		"address.city":      "is required when Street is set",
+		"billing.country":   "must be a valid iso3166_alpha2",
+		"items[1].sku":      "must be a valid alphanum",
+		"items[1].quantity": "must be at least 1",
+	}, fieldErrors(validator.ValidateStruct(s)))
+
+	s = validSignup()
+	s.Items = make([]lineItem, 3)
+	for i := range s.Items {
+		s.Items[i] = lineItem{SKU: "A1", Quantity: 1}
+	}
+	assert.Equal(map[string]string{"items": "must have at most 2 elements"}, fieldErrors(validator.ValidateStruct(s)))
+}
+
+func TestValidateStructTimesAndNumbers(t *testing.T) {
+	assert := assert.New(t)
+
+	type booking struct {
+		From     time.Time  `validate:"required"`
+		To       *time.Time `validate:"omitempty,gtefield=From"`
+		Min      float64
+		Max      float64 `validate:"gtfield=Min"`
+		Guests   uint8   `validate:"ltefield=Capacity"`
+		Capacity int
+	}
+
+	from := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	before := from.Add(-time.Hour)
+
+	assert.NoError(validator.ValidateStruct(booking{From: from, To: &from, Min: 1, Max: 1.5, Guests: 4, Capacity: 4}))
+	assert.Equal(map[string]string{
+		"From":   "is required",
+		"Max":    "must be after Min",
+		"Guests": "must not be after Capacity",
+	}, fieldErrors(validator.ValidateStruct(&booking{Min: 2, Max: 1, Guests: 5, Capacity: 4})))
+	assert.Equal(map[string]string{
+		"To": "must not be before From",
+	}, fieldErrors(validator.ValidateStruct(booking{From: from, To: &before, Max: 1})))
+}
+
+func TestValidateStructLargeNumbers(t *testing.T) {
+	assert := assert.New(t)
+
+	const maxExact = 1 << 53
+
+	testCases := []struct {
+		a, b     any
+		rule     string
+		expected bool
+	}{
+		{int64(maxExact + 1), int64(maxExact), "nefield", true},
+		{int64(maxExact + 1), int64(maxExact), "gtfield", true},
+		{int64(maxExact - 1), int64(maxExact), "ltfield", true},
+		{int64(maxExact + 1), int64(maxExact + 1), "eqfield", true},
+		{int64(maxExact), int64(maxExact + 1), "gtefield", false},
+		{uint64(maxExact + 1), uint64(maxExact), "gtfield", true},
+		{uint64(math.MaxUint64), uint64(math.MaxUint64 - 1), "nefield", true},
+		{uint64(math.MaxUint64), int64(math.MaxInt64), "gtfield", true},
+		{int64(math.MaxInt64), uint64(math.MaxUint64), "ltfield", true},
+		{int64(-1), uint64(math.MaxUint64), "ltfield", true},
+		{uint64(0), int64(-1), "gtfield", true},
+		{int64(maxExact + 1), uint64(maxExact + 1), "eqfield", true},
+		{int64(maxExact + 1), uint64(maxExact), "eqfield", false},
+		{uint8(200), int8(-100), "gtfield", true},
+		{1.5, int64(1), "gtfield", true},
+		{math.NaN(), 1.0, "ltefield", false},
+		{math.NaN(), 1.0, "gtefield", false},
+		{math.NaN(), math.NaN(), "eqfield", false},
+		{math.NaN(), math.NaN(), "nefield", true},
+	}
+
+	for _, testCase := range testCases {
+		structType := reflect.StructOf([]reflect.StructField{
+			{Name: "A", Type: reflect.TypeOf(testCase.b)},
+			{Name: "B", Type: reflect.TypeOf(testCase.a), Tag: reflect.StructTag(`validate:"` + testCase.rule + `=A"`)},
+		})
+		value := reflect.New(structType).Elem()
+		value.Field(0).Set(reflect.ValueOf(testCase.b))
+		value.Field(1).Set(reflect.ValueOf(testCase.a))
+		err := validator.ValidateStruct(value.Interface())
+		assert.Equal(testCase.expected, err == nil, "%v %s %v: %v", testCase.a, testCase.rule, testCase.b, err)
+	}
+
+	assert.NoError(validator.ValidateValue(int64(maxExact+1), "min=9007199254740993"))
+	assert.Error(validator.ValidateValue(int64(maxExact), "min=9007199254740993"))
+	assert.Error(validator.ValidateValue(int64(maxExact+1), "max=9007199254740992"))
+	assert.NoError(validator.ValidateValue(uint64(math.MaxUint64), "min=18446744073709551615"))
+	assert.NoError(validator.ValidateValue(uint8(0), "min=-1"))
+	assert.Error(validator.ValidateValue(math.NaN(), "min=0"))
+	assert.Error(validator.ValidateValue(math.NaN(), "min=0,max=1"))
+	assert.Error(validator.ValidateValue(math.NaN(), "max=1"))
+	assert.NoError(validator.ValidateValue(math.Inf(1), "min=0"))
+
+	var tagErr *validator.TagError
+	assert.True(errors.As(validator.ValidateValue(1.0, "min=NaN"), &tagErr))
+}
+
+func TestValidateStructCycles(t *testing.T) {
+	assert := assert.New(t)
+
+	type node struct {
+		Name string `validate:"required"`
+		Next *node
+	}
+
+	last := &node{Name: "c"}
+	first := &node{Name: "a", Next: &node{Name: "b", Next: last}}
+	assert.NoError(validator.ValidateStruct(first))
+
+	last.Next = first
+	err := validator.ValidateStruct(first)
+	var cycleErr *validator.CycleError
+	assert.True(errors.As(err, &cycleErr))
+	assert.Equal("validate: cycle at Next.Next.Next.Next", err.Error())
+
+	type tree struct {
+		Name     string `validate:"required"`
+		Children []tree
+	}
+
+	children := make([]tree, 1)
+	children[0] = tree{Name: "child", Children: children}
+	assert.True(errors.As(validator.ValidateStruct(tree{Name: "root", Children: children}), &cycleErr))
+
+	// Shared, acyclic pointers are validated wherever they appear.
+	shared := &node{}
+	assert.Equal(map[string]string{"First.Name": "is required", "Second.Name": "is required"},
+		fieldErrors(validator.ValidateStruct(struct {
+			First  *node
+			Second *node
+		}{shared, shared})))
+}
+
+func TestValidateStructEmbedded(t *testing.T) {
+	assert := assert.New(t)
+
+	type Location struct {
+		Street  string
+		City    string `validate:"required_with=Street"`
+		Country string `validate:"required,iso3166_alpha2"`
+	}
+	type customer struct {
+		Location
+		address
+		Name string `validate:"required,eqfield=Location.Country"`
+	}
+
+	// Fields of the embedded exported type are reached through its name;
+	// the embedded unexported type is skipped like other unexported fields.
+	err := validator.ValidateStruct(customer{Location: Location{Street: "Main Street 1"}, Name: "DE"})
+	assert.Equal(map[string]string{
+		"Location.City":    "is required when Street is set",
+		"Location.Country": "is required",
+		"Name":             "must equal Location.Country",
+	}, fieldErrors(err))
+}
+
+func TestValidateStructTagErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	var tagErr *validator.TagError
+
+	err := validator.ValidateStruct(struct {
+		Name string `validate:"required,bogus"`
+	}{Name: "x"})
+	assert.True(errors.As(err, &tagErr))
+	assert.Equal("validate: invalid tag \"bogus\" on Name: unknown rule", err.Error())
+
+	err = validator.ValidateStruct(struct {
+		Name string `validate:"omitempty,bogus"`
+	}{})
+	assert.True(errors.As(err, &tagErr))
+
+	err = validator.ValidateStruct(struct {
+		Name string `validate:"eqfield=Missing"`
+	}{})
+	assert.True(errors.As(err, &tagErr))
+
+	err = validator.ValidateStruct(struct {
+		Count int `validate:"email"`
+	}{})
+	assert.True(errors.As(err, &tagErr))
+
+	err = validator.ValidateStruct(struct {
+		Tags []string
+		Name string `validate:"gtfield=Tags"`
+	}{Name: "x"})
+	assert.True(errors.As(err, &tagErr))
+
+	assert.ErrorIs(validator.ValidateStruct("x"), validator.ErrNotStruct)
+	assert.ErrorIs(validator.ValidateStruct(nil), validator.ErrNotStruct)
+}
+
+func TestRegisterValidation(t *testing.T) {
+	assert := assert.New(t)
+
+	validator.RegisterValidation("lowercase", func(str string) bool {
+		return str == strings.ToLower(str)
+	})
+
+	type user struct {
+		Name string `validate:"lowercase"`
+	}
+
+	assert.NoError(validator.ValidateStruct(user{Name: "john"}))
+	assert.Equal(map[string]string{"Name": "must be a valid lowercase"}, fieldErrors(validator.ValidateStruct(user{Name: "John"})))
+}
+
+func TestValidations(t *testing.T) {
+	assert := assert.New(t)
+
+	validator.RegisterValidation("uppercase", func(str string) bool {
+		return str == strings.ToUpper(str)
+	})
+
+	validations := validator.Validations()
+	assert.True(validations["email"]("john.doe@example.com"))
+	assert.True(validations["uppercase"]("JOHN"))
+	assert.NotContains(validations, "required")
+
+	delete(validations, "email")
+	assert.Contains(validator.Validations(), "email")
+}
+
+func TestValidateValue(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NoError(validator.ValidateValue("john.doe@example.com", "required,email"))
+	assert.NoError(validator.ValidateValue("", "omitempty,email"))
+	assert.NoError(validator.ValidateValue(nil, "omitempty,min=3"))
+	assert.NoError(validator.ValidateValue(42, "min=1,max=100"))
+	assert.NoError(validator.ValidateValue("DE89370400440532013000", "iban"))
+
+	err := validator.ValidateValue("john.doe@", "required,email")
+	assert.ErrorIs(err, validator.ErrInvalid)
+	assert.Equal("must be a valid email", err.Error())
+
+	var fieldErr *validator.FieldError
+	assert.True(errors.As(err, &fieldErr))
+	assert.Equal("email", fieldErr.Rule)
+	assert.Equal("", fieldErr.Field)
+
+	assert.EqualError(validator.ValidateValue("", "required"), "is required")
+	assert.EqualError(validator.ValidateValue(nil, "required"), "is required")
+	assert.EqualError(validator.ValidateValue("enterprise", "oneof=free pro"), "must be one of free pro")
+	assert.EqualError(validator.ValidateValue([]string{"a"}, "min=2"), "must have at least 2 elements")
+
+	var tagErr *validator.TagError
+	assert.True(errors.As(validator.ValidateValue("", "omitempty,bogus"), &tagErr))
+	assert.True(errors.As(validator.ValidateValue("x", "eqfield=Other"), &tagErr))
+	assert.True(errors.As(validator.ValidateValue("x", "min=many"), &tagErr))
+	assert.True(errors.As(validator.ValidateValue(1, "email"), &tagErr))
+}
@@ -4,14 +4,15 @@ import (
 	"encoding/hex"
 	"golang.org/x/crypto/sha3"
 	"strings"
+	"unicode/utf8"
 )
 
 func IsAlpha(str string) bool {
-	return alphaRegex.MatchString(str)
+	return !exceedsMaxInputLength(str) && matchAlpha(str)
 }
 
 func IsAlphaNumeric(str string) bool {
-	return alphaNumericRegex.MatchString(str)
+	return !exceedsMaxInputLength(str) && matchAlphaNumeric(str)
 }
 
 func IsAlphaUnicode(str string) bool {
@@ -23,19 +24,19 @@ func IsAlphaUnicodeNumeric(str string) bool {
 }
 
 func IsNumeric(str string) bool {
-	return numericRegex.MatchString(str)
+	return !exceedsMaxInputLength(str) && matchNumeric(str)
 }
 
 func IsNumber(str string) bool {
-	return numberRegex.MatchString(str)
+	return !exceedsMaxInputLength(str) && matchNumber(str)
 }
 
 func IsHexadecimal(str string) bool {
-	return hexadecimalRegex.MatchString(str)
+	return !exceedsMaxInputLength(str) && matchHexadecimal(str)
 }
 
 func IsHexcolor(str string) bool {
-	return hexcolorRegex.MatchString(str)
+	return !exceedsMaxInputLength(str) && matchHexcolor(str)
 }
 
 func IsRGB(str string) bool {
@@ -59,17 +60,21 @@ func IsEmail(str string) bool {
 }
 
 func IsBase64(str string) bool {
-	return base64Regex.MatchString(str)
+	return !exceedsMaxInputLength(str) && matchBase64(str, false)
 }
 
 func IsBase64URL(str string) bool {
-	return base64URLRegex.MatchString(str)
+	return !exceedsMaxInputLength(str) && matchBase64(str, true)
 }
 
 func IsISBN10(str string) bool {
+	if exceedsMaxInputLength(str) {
+		return false
+	}
+
 	cleaned := strings.Replace(strings.Replace(str, "-", "", 3), " ", "", 3)
 
-	if !isbn10Regex.MatchString(cleaned) {
+	if !matchISBN10(cleaned) {
 		return false
 	}
 
@@ -90,9 +95,13 @@ func IsISBN10(str string) bool {
 }
 
 func IsISBN13(str string) bool {
+	if exceedsMaxInputLength(str) {
+		return false
+	}
+
 	cleaned := strings.Replace(strings.Replace(str, "-", "", 4), " ", "", 4)
 
-	if !isbn13Regex.MatchString(cleaned) {
+	if !matchISBN13(cleaned) {
 		return false
 	}
 
@@ -109,50 +118,58 @@ func IsISBN13(str string) bool {
 }
 
 func IsUUID3(str string) bool {
-	return uuid3Regex.MatchString(str)
+	return !exceedsMaxInputLength(str) && matchUUID(str, '3', false)
 }
 
 func IsUUID4(str string) bool {
-	return uuid4Regex.MatchString(str)
+	return !exceedsMaxInputLength(str) && matchUUID(str, '4', false)
 }
 
 func IsUUID5(str string) bool {
-	return uuid5Regex.MatchString(str)
+	return !exceedsMaxInputLength(str) && matchUUID(str, '5', false)
 }
 
 func IsUUID(str string) bool {
-	return uuidRegex.MatchString(str)
+	return !exceedsMaxInputLength(str) && matchUUID(str, 0, false)
 }
 
 func IsUUID3Mixed(str string) bool {
-	return uuid3MixedRegex.MatchString(str)
+	return !exceedsMaxInputLength(str) && matchUUID(str, '3', true)
 }
 
 func IsUUID4Mixed(str string) bool {
-	return uuid4MixedRegex.MatchString(str)
+	return !exceedsMaxInputLength(str) && matchUUID(str, '4', true)
 }
 
 func IsUUID5Mixed(str string) bool {
-	return uuid5MixedRegex.MatchString(str)
+	return !exceedsMaxInputLength(str) && matchUUID(str, '5', true)
 }
 
 func IsUUIDMixed(str string) bool {
-	return uuidMixedRegex.MatchString(str)
+	return !exceedsMaxInputLength(str) && matchUUID(str, 0, true)
 }
 
 func IsASCII(str string) bool {
-	return asciiRegex.MatchString(str)
+	return !exceedsMaxInputLength(str) && matchASCII(str)
 }
 
 func IsPrintableASCII(str string) bool {
-	return printableASCIIRegex.MatchString(str)
+	return !exceedsMaxInputLength(str) && matchPrintableASCII(str)
 }
 
+// HasMultibyteChar reports whether str contains a non-ASCII character. Invalid
+// UTF-8 is rejected rather than counted as a multibyte character.
 func HasMultibyteChar(str string) bool {
-	return multibyteCharRegex.MatchString(str)
+	return !exceedsMaxInputLength(str) && !matchASCII(str) && utf8.ValidString(str)
 }
 
+// IsDataURI reports whether str is a base64 data URI. Inputs longer than
+// MaxInputLength are rejected.
 func IsDataURI(str string) bool {
+	if exceedsMaxInputLength(str) {
+		return false
+	}
+
 	uri := strings.SplitN(str, ",", 2)
 
 	if len(uri) != 2 {
@@ -163,7 +180,7 @@ func IsDataURI(str string) bool {
 		return false
 	}
 
-	return base64Regex.MatchString(uri[1])
+	return matchBase64(uri[1], false)
 }
 
 func IsLatitude(str string) bool {
@@ -188,7 +205,7 @@ func IsDomainName(str string) bool {
 }
 
 func IsETHAddress(str string) bool {
-	if !ethAddressRegex.MatchString(str) {
+	if exceedsMaxInputLength(str) || !matchETHAddress(str, 0) {
 		return false
 	}
 
@@ -214,11 +231,11 @@ func IsETHAddress(str string) bool {
 }
 
 func isETHAddressLower(str string) bool {
-	return ethAddressRegexLower.MatchString(str)
+	return matchETHAddress(str, 'a')
 }
 
 func isETHAddressUpper(str string) bool {
-	return ethAddressRegexUpper.MatchString(str)
+	return matchETHAddress(str, 'A')
 }
 
 func IsURLEncoded(str string) bool {
@@ -229,10 +246,6 @@ func IsHTMLEncoded(str string) bool {
 	return htmlEncodedRegex.MatchString(str)
 }
 
-func IsHTML(str string) bool {
-	return htmlRegex.MatchString(str)
-}
-
 func IsEmpty(str string) bool {
 	return strings.Trim(str, " ") == ""
 }
\ No newline at end of file
@@ -279,6 +279,7 @@ func TestHasMultibyteChar(t *testing.T) {
 		{"test＠example.com", true},
 		{"1234abcDEｘｙｚ", true},
 		{"ｶﾀｶﾅ", true},
+		{"abc\xff", false},
 	}
 
 	for _, t := range testCases {
@@ -765,6 +766,9 @@ func TestIsAlphaUnicodeNumeric(t *testing.T) {
 		{"test＠example.com", false},
 		{"1234abcDE", true},
 		{"ｶﾀｶﾅ", true},
+		{"abc\xff", false},
+		{"cafe\u03012", true},
+		{"\u0301abc", false},
 	}
 
 	for _, t := range testCases {
@@ -788,6 +792,10 @@ func TestIsAlphaUnicode(t *testing.T) {
 		{"test＠example.com", false},
 		{"1234abcDE", false},
 		{"ｶﾀｶﾅ", true},
+		{"abc\xff", false},
+		{"cafe\u0301", true},
+		{"हिन्दी", true},
+		{"\u0301abc", false},
 	}
 
 	for _, t := range testCases {